@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+
+	"github.com/longhorn/longhorn-engine/pkg/util"
+	"github.com/longhorn/longhorn-engine/proto/ptypes"
+)
+
+// initAuditLog makes the gRPC servers of the process record mutating calls
+// into the file given by the global audit-log flag. Only the commands running
+// gRPC servers call it, so client commands never open the file.
+func initAuditLog(c *cli.Context) error {
+	path := c.GlobalString("audit-log")
+	if path == "" {
+		return nil
+	}
+
+	l, err := util.OpenAuditLog(path)
+	if err != nil {
+		return err
+	}
+	ptypes.SetAuditLog(l)
+	return nil
+}
+
+// auditLogGlobalArgs returns the global audit-log flag of the current command,
+// for passing it on to a child process.
+func auditLogGlobalArgs(c *cli.Context) []string {
+	if path := c.GlobalString("audit-log"); path != "" {
+		return []string{"--audit-log", path}
+	}
+	return nil
+}
+
+func AuditLogCmd() cli.Command {
+	return cli.Command{
+		Name:      "audit-log",
+		Usage:     "Show the control operations recorded in an audit log",
+		ArgsUsage: "<audit log file>",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "since",
+				Usage: "Only show operations started at or after this RFC3339 time",
+			},
+			cli.StringFlag{
+				Name:  "method",
+				Usage: "Only show operations whose method contains this string, e.g. SnapshotCreate",
+			},
+			cli.BoolFlag{
+				Name:  "failed",
+				Usage: "Only show operations that did not succeed",
+			},
+		},
+		Action: func(c *cli.Context) {
+			if err := showAuditLog(c); err != nil {
+				logrus.WithError(err).Fatalf("Error running audit-log command")
+			}
+		},
+	}
+}
+
+func showAuditLog(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return errors.New("audit log file is required")
+	}
+
+	var since time.Time
+	if sinceString := c.String("since"); sinceString != "" {
+		var err error
+		if since, err = time.Parse(time.RFC3339, sinceString); err != nil {
+			return errors.Wrapf(err, "invalid since time %v", sinceString)
+		}
+	}
+	method := c.String("method")
+	failedOnly := c.Bool("failed")
+
+	records, err := util.ReadAuditLog(c.Args()[0])
+	if err != nil {
+		return err
+	}
+
+	format := "%s\t%s\t%s\t%s\t%s\t%v\t%s\n"
+	tw := tabwriter.NewWriter(os.Stdout, 0, 20, 1, ' ', 0)
+	fmt.Fprintf(tw, format, "TIME", "METHOD", "TARGET", "CALLER", "RESULT", "DURATION", "ERROR")
+	for _, r := range records {
+		if r.Time.Before(since) || !strings.Contains(r.Method, method) {
+			continue
+		}
+		if failedOnly && r.Error == "" {
+			continue
+		}
+		fmt.Fprintf(tw, format, r.Time.Format(time.RFC3339), r.Method, r.Target, r.Caller, r.Code, r.Duration, r.Error)
+	}
+	tw.Flush()
+
+	return nil
+}
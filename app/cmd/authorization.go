@@ -0,0 +1,28 @@
+package cmd
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/longhorn/longhorn-engine/proto/ptypes"
+)
+
+const (
+	ControlTokenEnv = "LONGHORN_CONTROL_TOKEN"
+)
+
+// InitControlToken sets the token required by the gRPC servers of the process
+// for mutating calls, and sent by its gRPC clients, from the global
+// control-token flag.
+func InitControlToken(c *cli.Context) {
+	ptypes.SetControlToken(c.GlobalString("control-token"))
+}
+
+// controlTokenEnv returns the environment entry passing the control token of
+// the current command on to a child process. The token is kept out of the
+// command line of the child, where any local user could read it.
+func controlTokenEnv(c *cli.Context) []string {
+	if token := c.GlobalString("control-token"); token != "" {
+		return []string{ControlTokenEnv + "=" + token}
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"crypto/tls"
 	"os"
 	"strings"
 	"syscall"
@@ -17,6 +18,8 @@ import (
 	"github.com/longhorn/longhorn-engine/pkg/controller"
 	"github.com/longhorn/longhorn-engine/pkg/controller/client"
 	controllerrpc "github.com/longhorn/longhorn-engine/pkg/controller/rpc"
+	"github.com/longhorn/longhorn-engine/pkg/frontend/nbd"
+	"github.com/longhorn/longhorn-engine/pkg/frontend/tgt"
 	"github.com/longhorn/longhorn-engine/pkg/types"
 	"github.com/longhorn/longhorn-engine/pkg/util"
 )
@@ -92,6 +95,67 @@ func ControllerCmd() cli.Command {
 				Name:  "snapshot-max-size",
 				Usage: "Maximum total snapshot size in bytes or human readable 42kb, 42mb, 42gb",
 			},
+			cli.StringFlag{
+				Name:  "nbd-listen",
+				Value: nbd.DefaultListenAddress,
+				Usage: "Listen address of the nbd frontend",
+			},
+			cli.StringFlag{
+				Name:   "iscsi-chap-username",
+				EnvVar: "ISCSI_CHAP_USERNAME",
+				Usage:  "Username of the initiator for CHAP authentication of the tgt-iscsi frontend",
+			},
+			cli.StringFlag{
+				Name:   "iscsi-chap-password",
+				EnvVar: "ISCSI_CHAP_PASSWORD",
+				Usage:  "Password of the initiator for CHAP authentication of the tgt-iscsi frontend, 12 to 16 characters",
+			},
+			cli.StringFlag{
+				Name:   "iscsi-chap-mutual-username",
+				EnvVar: "ISCSI_CHAP_MUTUAL_USERNAME",
+				Usage:  "Username of the target for mutual CHAP authentication of the tgt-iscsi frontend",
+			},
+			cli.StringFlag{
+				Name:   "iscsi-chap-mutual-password",
+				EnvVar: "ISCSI_CHAP_MUTUAL_PASSWORD",
+				Usage:  "Password of the target for mutual CHAP authentication of the tgt-iscsi frontend, 12 to 16 characters",
+			},
+			cli.StringFlag{
+				Name:  "data-tls-cert",
+				Usage: "Client certificate file for TLS data connections to the replicas",
+			},
+			cli.StringFlag{
+				Name:  "data-tls-key",
+				Usage: "Client key file for TLS data connections to the replicas",
+			},
+			cli.StringFlag{
+				Name:  "data-tls-ca",
+				Usage: "CA file to verify the replicas with. Setting any data-tls option enables TLS for tcp data connections",
+			},
+			cli.StringFlag{
+				Name:  "data-tls-server-name",
+				Usage: "Server name expected in the replica certificates instead of the replica host",
+			},
+			cli.BoolFlag{
+				Name:  "emulate-512e",
+				Usage: "Present 512-byte logical and 4096-byte physical sectors (512e) through the tgt frontends",
+			},
+			cli.StringFlag{
+				Name:  "debug-listen",
+				Usage: "Address of an HTTP listener serving pprof profiles, goroutine dumps and expvar variables. It is unauthenticated, disabled by default",
+			},
+			cli.StringFlag{
+				Name:  "metrics-listen",
+				Usage: "Address of an HTTP listener serving the volume metrics in Prometheus format under /metrics, disabled by default",
+			},
+			cli.Int64Flag{
+				Name:  "iops-limit",
+				Usage: "Maximum number of read, write and unmap operations per second of the volume, 0 for unlimited. It can be changed at runtime with the io-limits command",
+			},
+			cli.StringFlag{
+				Name:  "bandwidth-limit",
+				Usage: "Maximum number of bytes read and written per second by the volume, in bytes or human readable 42kb, 42mb, 42gb. Empty for unlimited. It can be changed at runtime with the io-limits command",
+			},
 		},
 		Action: func(c *cli.Context) {
 			if err := startController(c); err != nil {
@@ -111,6 +175,11 @@ func startController(c *cli.Context) error {
 	if !util.ValidVolumeName(volumeName) {
 		return errors.New("invalid target name")
 	}
+	logrus.AddHook(util.NewLogFieldsHook(logrus.Fields{"volume": volumeName}))
+
+	if err := initAuditLog(c); err != nil {
+		return err
+	}
 
 	listen := c.String("listen")
 	backends := c.StringSlice("enable-backend")
@@ -123,6 +192,23 @@ func startController(c *cli.Context) error {
 	dataServerProtocol := c.String("data-server-protocol")
 	fileSyncHTTPClientTimeout := c.Int("file-sync-http-client-timeout")
 	engineInstanceName := c.GlobalString("engine-instance-name")
+	nbdListenAddress := c.String("nbd-listen")
+	iscsiChap := &tgt.ChapCredentials{
+		Username:       c.String("iscsi-chap-username"),
+		Password:       c.String("iscsi-chap-password"),
+		MutualUsername: c.String("iscsi-chap-mutual-username"),
+		MutualPassword: c.String("iscsi-chap-mutual-password"),
+	}
+	if err := iscsiChap.Validate(); err != nil {
+		return err
+	}
+	emulate512e := c.Bool("emulate-512e")
+
+	if debugListen := c.String("debug-listen"); debugListen != "" {
+		if err := util.StartDebugServer(debugListen); err != nil {
+			return err
+		}
+	}
 
 	size := c.String("size")
 	if size == "" {
@@ -147,6 +233,15 @@ func startController(c *cli.Context) error {
 	engineReplicaTimeout = controller.DetermineEngineReplicaTimeout(engineReplicaTimeout)
 	iscsiTargetRequestTimeout := controller.DetermineIscsiTargetRequestTimeout(engineReplicaTimeout)
 
+	iopsLimit := c.Int64("iops-limit")
+	if iopsLimit < 0 {
+		return errors.New("iops-limit cannot be negative")
+	}
+	bandwidthLimit, err := parseBandwidthLimit(c.String("bandwidth-limit"))
+	if err != nil {
+		return err
+	}
+
 	snapshotMaxCount := c.Int("snapshot-max-count")
 	snapshotMaxSize := int64(0)
 	snapshotMaxSizeString := c.String("snapshot-max-size")
@@ -157,21 +252,39 @@ func startController(c *cli.Context) error {
 		}
 	}
 
+	var dataTLSConfig *tls.Config
+	dataTLSFiles := &util.TLSFiles{
+		CertFile: c.String("data-tls-cert"),
+		KeyFile:  c.String("data-tls-key"),
+		CAFile:   c.String("data-tls-ca"),
+	}
+	if dataTLSFiles.Enabled() {
+		if dataTLSConfig, err = util.NewClientTLSConfig(dataTLSFiles, c.String("data-tls-server-name")); err != nil {
+			return errors.Wrap(err, "failed to set up TLS for replica data connections")
+		}
+	}
+
 	factories := map[string]types.BackendFactory{}
 	for _, backend := range backends {
 		switch backend {
 		case "file":
 			factories[backend] = file.New()
 		case "tcp":
-			factories[backend] = remote.New()
+			factories[backend] = remote.New(dataTLSConfig)
 		default:
 			logrus.Fatalf("Unsupported backend: %s", backend)
 		}
 	}
 
+	frontendOptions := controller.FrontendOptions{
+		ISCSITargetRequestTimeout: iscsiTargetRequestTimeout,
+		NBDListenAddress:          nbdListenAddress,
+		ISCSIChap:                 iscsiChap,
+		Emulate512e:               emulate512e,
+	}
 	var frontend types.Frontend
 	if frontendName != "" {
-		f, err := controller.NewFrontend(frontendName, iscsiTargetRequestTimeout)
+		f, err := controller.NewFrontend(frontendName, frontendOptions)
 		if err != nil {
 			return errors.Wrapf(err, "failed to find frontend: %s", frontendName)
 		}
@@ -181,8 +294,17 @@ func startController(c *cli.Context) error {
 	logrus.Infof("Creating volume %v controller with iSCSI target request timeout %v and engine to replica(s) timeout %v",
 		volumeName, iscsiTargetRequestTimeout, engineReplicaTimeout)
 	control := controller.NewController(volumeName, dynamic.New(factories), frontend, isUpgrade, disableRevCounter, salvageRequested,
-		unmapMarkSnapChainRemoved, iscsiTargetRequestTimeout, engineReplicaTimeout, types.DataServerProtocol(dataServerProtocol),
+		unmapMarkSnapChainRemoved, frontendOptions, engineReplicaTimeout, types.DataServerProtocol(dataServerProtocol),
 		fileSyncHTTPClientTimeout, snapshotMaxCount, snapshotMaxSize)
+	if err := control.SetIOLimits(iopsLimit, bandwidthLimit); err != nil {
+		return err
+	}
+
+	if metricsListen := c.String("metrics-listen"); metricsListen != "" {
+		if err := control.StartMetricsServer(metricsListen); err != nil {
+			return err
+		}
+	}
 
 	// need to wait for Shutdown() completion
 	control.ShutdownWG.Add(1)
@@ -208,7 +330,10 @@ func startController(c *cli.Context) error {
 	}
 
 	control.GRPCAddress = util.GetGRPCAddress(listen)
-	control.GRPCServer = controllerrpc.GetControllerGRPCServer(volumeName, engineInstanceName, control)
+	control.GRPCServer, err = controllerrpc.GetControllerGRPCServer(volumeName, engineInstanceName, control)
+	if err != nil {
+		return err
+	}
 
 	control.StartGRPCServer()
 	return control.WaitForShutdown()
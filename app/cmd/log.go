@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/longhorn/longhorn-engine/pkg/controller/client"
+	"github.com/longhorn/longhorn-engine/proto/ptypes"
+)
+
+func LogLevelCmd() cli.Command {
+	return cli.Command{
+		Name:      "log-level",
+		ArgsUsage: "[level]",
+		Usage:     "Show the log level of the controller, replica or sync agent at --url, or change it to level, e.g. debug",
+		Action: func(c *cli.Context) {
+			if err := logLevel(c); err != nil {
+				logrus.WithError(err).Fatalf("Error running log-level command")
+			}
+		},
+	}
+}
+
+func logLevel(c *cli.Context) error {
+	url := c.GlobalString("url")
+	conn, err := grpc.Dial(url, ptypes.WithClientTransportCredentials(),
+		ptypes.WithIdentityValidationClientInterceptor(c.GlobalString("volume-name"), c.GlobalString("engine-instance-name")))
+	if err != nil {
+		return errors.Wrapf(err, "cannot connect to LogService %v", url)
+	}
+	defer conn.Close()
+
+	logClient := ptypes.NewLogServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), client.GRPCServiceTimeout)
+	defer cancel()
+
+	var level *ptypes.LogLevel
+	if c.NArg() == 0 {
+		if level, err = logClient.LogLevelGet(ctx, &emptypb.Empty{}); err != nil {
+			return errors.Wrapf(err, "failed to get the log level of %v", url)
+		}
+	} else {
+		if level, err = logClient.LogLevelSet(ctx, &ptypes.LogLevel{Level: c.Args().First()}); err != nil {
+			return errors.Wrapf(err, "failed to set the log level of %v", url)
+		}
+	}
+
+	fmt.Println(level.Level)
+	return nil
+}
+
+// logGlobalArgs returns the global logging flags of the current command, for
+// passing them on to a child process.
+func logGlobalArgs(c *cli.Context) []string {
+	args := []string{
+		"--log-level", c.GlobalString("log-level"),
+		"--log-format", c.GlobalString("log-format"),
+	}
+	if c.GlobalBool("debug") {
+		args = append(args, "--debug")
+	}
+	return args
+}
@@ -39,7 +39,10 @@ func getProfilerClient(c *cli.Context) (*profiler.Client, error) {
 	url := c.GlobalString("url")
 	volumeName := c.GlobalString("volume-name")
 	engineInstanceName := c.GlobalString("engine-instance-name")
-	dialOpts := []grpc.DialOption{ptypes.WithIdentityValidationClientInterceptor(volumeName, engineInstanceName)}
+	dialOpts := []grpc.DialOption{
+		ptypes.WithClientTransportCredentials(),
+		ptypes.WithIdentityValidationClientInterceptor(volumeName, engineInstanceName),
+	}
 	return profiler.NewClient(url, volumeName, dialOpts...)
 }
 
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"net"
@@ -82,6 +83,22 @@ func ReplicaCmd() cli.Command {
 				Name:  "snapshot-max-size",
 				Usage: "Maximum total snapshot size in bytes or human readable 42kb, 42mb, 42gb",
 			},
+			cli.StringFlag{
+				Name:  "data-tls-cert",
+				Usage: "Certificate file of the data server. Setting it enables TLS for the tcp data server",
+			},
+			cli.StringFlag{
+				Name:  "data-tls-key",
+				Usage: "Key file of the data server",
+			},
+			cli.StringFlag{
+				Name:  "data-tls-ca",
+				Usage: "CA file to verify the controller client certificates with",
+			},
+			cli.StringFlag{
+				Name:  "debug-listen",
+				Usage: "Address of an HTTP listener serving pprof profiles, goroutine dumps and expvar variables. It is unauthenticated, disabled by default",
+			},
 		},
 		Action: func(c *cli.Context) {
 			if err := startReplica(c); err != nil {
@@ -96,6 +113,10 @@ func startReplica(c *cli.Context) error {
 		return errors.New("directory name is required")
 	}
 
+	if err := initAuditLog(c); err != nil {
+		return err
+	}
+
 	dir := c.Args()[0]
 	backingFile, err := backingfile.OpenBackingFile(c.String("backing-file"))
 	if err != nil {
@@ -105,6 +126,12 @@ func startReplica(c *cli.Context) error {
 	disableRevCounter := c.Bool("disableRevCounter")
 	unmapMarkDiskChainRemoved := c.Bool("unmap-mark-disk-chain-removed")
 
+	if debugListen := c.String("debug-listen"); debugListen != "" {
+		if err := util.StartDebugServer(debugListen); err != nil {
+			return err
+		}
+	}
+
 	snapshotMaxCount := c.Int("snapshot-max-count")
 	snapshotMaxSize := int64(0)
 	snapshotMaxSizeString := c.String("snapshot-max-size")
@@ -134,6 +161,7 @@ func startReplica(c *cli.Context) error {
 	volumeName := c.GlobalString("volume-name")
 	replicaInstanceName := c.String("replica-instance-name")
 	dataServerProtocol := c.String("data-server-protocol")
+	logrus.AddHook(util.NewLogFieldsHook(logrus.Fields{"volume": volumeName, "replica": replicaInstanceName}))
 
 	controlAddress, dataAddress, syncAddress, syncPort, err :=
 		util.GetAddresses(volumeName, address, types.DataServerProtocol(dataServerProtocol))
@@ -144,6 +172,12 @@ func startReplica(c *cli.Context) error {
 	resp := make(chan error)
 
 	go func() {
+		server, err := replicarpc.NewReplicaServer(volumeName, replicaInstanceName, s)
+		if err != nil {
+			resp <- err
+			return
+		}
+
 		listen, err := net.Listen("tcp", controlAddress)
 		if err != nil {
 			logrus.WithError(err).Warnf("Failed to listen %v", controlAddress)
@@ -151,16 +185,26 @@ func startReplica(c *cli.Context) error {
 			return
 		}
 
-		server := replicarpc.NewReplicaServer(volumeName, replicaInstanceName, s)
-
 		logrus.Infof("Listening on gRPC Replica server %s", controlAddress)
 		err = server.Serve(listen)
 		logrus.WithError(err).Warnf("gRPC Replica server at %v is down", controlAddress)
 		resp <- err
 	}()
 
+	var dataTLSConfig *tls.Config
+	dataTLSFiles := &util.TLSFiles{
+		CertFile: c.String("data-tls-cert"),
+		KeyFile:  c.String("data-tls-key"),
+		CAFile:   c.String("data-tls-ca"),
+	}
+	if dataTLSFiles.Enabled() {
+		if dataTLSConfig, err = util.NewServerTLSConfig(dataTLSFiles); err != nil {
+			return err
+		}
+	}
+
 	go func() {
-		rpcServer := replicarpc.NewDataServer(types.DataServerProtocol(dataServerProtocol), dataAddress, s)
+		rpcServer := replicarpc.NewDataServer(types.DataServerProtocol(dataServerProtocol), dataAddress, s, dataTLSConfig)
 		logrus.Infof("Listening on data server %s", dataAddress)
 		err := rpcServer.ListenAndServe()
 		logrus.WithError(err).Warnf("Replica rest server at %v is down", dataAddress)
@@ -179,15 +223,22 @@ func startReplica(c *cli.Context) error {
 		}
 
 		go func() {
-			cmd := exec.Command(exe, "--volume-name", volumeName, "sync-agent", "--listen", syncAddress,
+			args := logGlobalArgs(c)
+			args = append(args, grpcTLSGlobalArgs(c)...)
+			args = append(args, auditLogGlobalArgs(c)...)
+			args = append(args, "--volume-name", volumeName, "sync-agent", "--listen", syncAddress,
 				"--replica", controlAddress,
 				"--listen-port-range",
 				fmt.Sprintf("%v-%v", syncPort+1, syncPort+c.Int("sync-agent-port-count")),
 				"--replica-instance-name", replicaInstanceName)
+			cmd := exec.Command(exe, args...)
 			cmd.SysProcAttr = &syscall.SysProcAttr{
 				Pdeathsig: syscall.SIGKILL,
 			}
 			cmd.Dir = dir
+			if env := controlTokenEnv(c); env != nil {
+				cmd.Env = append(os.Environ(), env...)
+			}
 			cmd.Stdout = os.Stdout
 			cmd.Stderr = os.Stderr
 			logrus.Infof("Listening on sync agent server %s", syncAddress)
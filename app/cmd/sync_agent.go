@@ -13,6 +13,7 @@ import (
 
 	"github.com/longhorn/longhorn-engine/pkg/sync"
 	syncagentrpc "github.com/longhorn/longhorn-engine/pkg/sync/rpc"
+	"github.com/longhorn/longhorn-engine/pkg/util"
 )
 
 func SyncAgentCmd() cli.Command {
@@ -63,6 +64,10 @@ func startSyncAgent(c *cli.Context) error {
 	replicaAddress := c.String("replica")
 	volumeName := c.GlobalString("volume-name")
 	replicaInstanceName := c.String("replica-instance-name")
+	logrus.AddHook(util.NewLogFieldsHook(logrus.Fields{"volume": volumeName, "replica": replicaInstanceName}))
+	if err := initAuditLog(c); err != nil {
+		return err
+	}
 
 	parts := strings.Split(portRange, "-")
 	if len(parts) != 2 {
@@ -84,7 +89,10 @@ func startSyncAgent(c *cli.Context) error {
 		return errors.Wrap(err, "failed to listen")
 	}
 
-	server := syncagentrpc.NewSyncAgentServer(start, end, replicaAddress, volumeName, replicaInstanceName)
+	server, err := syncagentrpc.NewSyncAgentServer(start, end, replicaAddress, volumeName, replicaInstanceName)
+	if err != nil {
+		return err
+	}
 
 	logrus.Infof("Listening on sync %s", listenPort)
 
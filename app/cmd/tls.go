@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+
+	"github.com/longhorn/longhorn-engine/pkg/util"
+	"github.com/longhorn/longhorn-engine/proto/ptypes"
+)
+
+var grpcTLSFlagNames = []string{"grpc-tls-cert", "grpc-tls-key", "grpc-tls-ca", "grpc-tls-server-name"}
+
+// InitGRPCTLS sets up TLS for the gRPC servers and clients of the process
+// from the global grpc-tls flags.
+func InitGRPCTLS(c *cli.Context) error {
+	files := &util.TLSFiles{
+		CertFile: c.GlobalString("grpc-tls-cert"),
+		KeyFile:  c.GlobalString("grpc-tls-key"),
+		CAFile:   c.GlobalString("grpc-tls-ca"),
+	}
+	if !files.Enabled() {
+		return nil
+	}
+
+	reloader, err := util.NewTLSReloader(files)
+	if err != nil {
+		return errors.Wrap(err, "failed to set up gRPC TLS")
+	}
+	ptypes.SetGRPCTLS(reloader, c.GlobalString("grpc-tls-server-name"))
+	return nil
+}
+
+// grpcTLSGlobalArgs returns the global grpc-tls flags of the current command,
+// for passing them on to a child process.
+func grpcTLSGlobalArgs(c *cli.Context) []string {
+	var args []string
+	for _, name := range grpcTLSFlagNames {
+		if value := c.GlobalString(name); value != "" {
+			args = append(args, "--"+name, value)
+		}
+	}
+	return args
+}
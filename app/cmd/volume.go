@@ -1,11 +1,16 @@
 package cmd
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
+	"github.com/docker/go-units"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
+
+	"github.com/longhorn/longhorn-engine/pkg/types"
 )
 
 func InfoCmd() cli.Command {
@@ -57,6 +62,40 @@ func UnmapMarkSnapChainRemovedCmd() cli.Command {
 	}
 }
 
+func IOLimitsCmd() cli.Command {
+	return cli.Command{
+		Name: "io-limits",
+		Flags: []cli.Flag{
+			cli.Int64Flag{
+				Name:  "iops",
+				Usage: "Maximum number of read, write and unmap operations per second of the volume, 0 for unlimited. Unchanged if not specified",
+			},
+			cli.StringFlag{
+				Name:  "bandwidth",
+				Usage: "Maximum number of bytes read and written per second by the volume, in bytes or human readable 42kb, 42mb, 42gb, 0 for unlimited. Unchanged if not specified",
+			},
+		},
+		Usage: "Change the IO limits of the running volume",
+		Action: func(c *cli.Context) {
+			if err := ioLimits(c); err != nil {
+				logrus.WithError(err).Fatalf("Error running io-limits command")
+			}
+		},
+	}
+}
+
+func EventsCmd() cli.Command {
+	return cli.Command{
+		Name:  "events",
+		Usage: "Print the events of the volume as they happen, one JSON object per line",
+		Action: func(c *cli.Context) {
+			if err := events(c); err != nil {
+				logrus.WithError(err).Fatalf("Error running events command")
+			}
+		},
+	}
+}
+
 func FrontendCmd() cli.Command {
 	return cli.Command{
 		Name: "frontend",
@@ -112,6 +151,71 @@ func info(c *cli.Context) error {
 	return nil
 }
 
+func events(c *cli.Context) error {
+	controllerClient, err := getControllerClient(c)
+	if err != nil {
+		return err
+	}
+	defer controllerClient.Close()
+
+	return controllerClient.VolumeEventWatch(context.Background(), func(event *types.VolumeEvent) error {
+		output, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(output))
+		return nil
+	})
+}
+
+func ioLimits(c *cli.Context) error {
+	if !c.IsSet("iops") && !c.IsSet("bandwidth") {
+		return fmt.Errorf("neither iops nor bandwidth is specified")
+	}
+
+	controllerClient, err := getControllerClient(c)
+	if err != nil {
+		return err
+	}
+	defer controllerClient.Close()
+
+	volumeInfo, err := controllerClient.VolumeGet()
+	if err != nil {
+		return err
+	}
+
+	iops := volumeInfo.IOPSLimit
+	if c.IsSet("iops") {
+		if iops = c.Int64("iops"); iops < 0 {
+			return errors.New("iops cannot be negative")
+		}
+	}
+	bandwidth := volumeInfo.BandwidthLimit
+	if c.IsSet("bandwidth") {
+		if bandwidth, err = parseBandwidthLimit(c.String("bandwidth")); err != nil {
+			return err
+		}
+	}
+
+	return controllerClient.VolumeIOLimitsSet(iops, bandwidth)
+}
+
+// parseBandwidthLimit parses a bandwidth limit in bytes per second, empty
+// meaning unlimited.
+func parseBandwidthLimit(limit string) (int64, error) {
+	if limit == "" {
+		return 0, nil
+	}
+	bandwidth, err := units.RAMInBytes(limit)
+	if err != nil {
+		return 0, err
+	}
+	if bandwidth < 0 {
+		return 0, errors.New("bandwidth limit cannot be negative")
+	}
+	return bandwidth, nil
+}
+
 func expand(c *cli.Context) error {
 	size := c.Int64("size")
 	controllerClient, err := getControllerClient(c)
@@ -11,9 +11,10 @@ from google.protobuf.internal import builder as _builder
 _sym_db = _symbol_database.Default()
 
 
+from google.protobuf import empty_pb2 as google_dot_protobuf_dot_empty__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n=github.com/longhorn/longhorn-engine/proto/ptypes/common.proto\x12\x06ptypes\"Q\n\x0cSyncFileInfo\x12\x16\n\x0e\x66rom_file_name\x18\x01 \x01(\t\x12\x14\n\x0cto_file_name\x18\x02 \x01(\t\x12\x13\n\x0b\x61\x63tual_size\x18\x03 \x01(\x03\x42\x32Z0github.com/longhorn/longhorn-engine/proto/ptypesb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n=github.com/longhorn/longhorn-engine/proto/ptypes/common.proto\x12\x06ptypes\x1a\x1bgoogle/protobuf/empty.proto\"Q\n\x0cSyncFileInfo\x12\x16\n\x0e\x66rom_file_name\x18\x01 \x01(\t\x12\x14\n\x0cto_file_name\x18\x02 \x01(\t\x12\x13\n\x0b\x61\x63tual_size\x18\x03 \x01(\x03\"\x19\n\x08LogLevel\x12\r\n\x05level\x18\x01 \x01(\t2x\n\nLogService\x12\x37\n\x0bLogLevelGet\x12\x16.google.protobuf.Empty\x1a\x10.ptypes.LogLevel\x12\x31\n\x0bLogLevelSet\x12\x10.ptypes.LogLevel\x1a\x10.ptypes.LogLevelB2Z0github.com/longhorn/longhorn-engine/proto/ptypesb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -22,6 +23,10 @@ if _descriptor._USE_C_DESCRIPTORS == False:
 
   DESCRIPTOR._options = None
   DESCRIPTOR._serialized_options = b'Z0github.com/longhorn/longhorn-engine/proto/ptypes'
-  _globals['_SYNCFILEINFO']._serialized_start=73
-  _globals['_SYNCFILEINFO']._serialized_end=154
+  _globals['_SYNCFILEINFO']._serialized_start=102
+  _globals['_SYNCFILEINFO']._serialized_end=183
+  _globals['_LOGLEVEL']._serialized_start=185
+  _globals['_LOGLEVEL']._serialized_end=210
+  _globals['_LOGSERVICE']._serialized_start=212
+  _globals['_LOGSERVICE']._serialized_end=332
 # @@protoc_insertion_point(module_scope)
@@ -2,3 +2,99 @@
 """Client and server classes corresponding to protobuf-defined services."""
 import grpc
 
+from github.com.longhorn.longhorn_engine.proto.ptypes import common_pb2 as github_dot_com_dot_longhorn_dot_longhorn__engine_dot_proto_dot_ptypes_dot_common__pb2
+from google.protobuf import empty_pb2 as google_dot_protobuf_dot_empty__pb2
+
+
+class LogServiceStub(object):
+    """Missing associated documentation comment in .proto file."""
+
+    def __init__(self, channel):
+        """Constructor.
+
+        Args:
+            channel: A grpc.Channel.
+        """
+        self.LogLevelGet = channel.unary_unary(
+                '/ptypes.LogService/LogLevelGet',
+                request_serializer=google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
+                response_deserializer=github_dot_com_dot_longhorn_dot_longhorn__engine_dot_proto_dot_ptypes_dot_common__pb2.LogLevel.FromString,
+                )
+        self.LogLevelSet = channel.unary_unary(
+                '/ptypes.LogService/LogLevelSet',
+                request_serializer=github_dot_com_dot_longhorn_dot_longhorn__engine_dot_proto_dot_ptypes_dot_common__pb2.LogLevel.SerializeToString,
+                response_deserializer=github_dot_com_dot_longhorn_dot_longhorn__engine_dot_proto_dot_ptypes_dot_common__pb2.LogLevel.FromString,
+                )
+
+
+class LogServiceServicer(object):
+    """Missing associated documentation comment in .proto file."""
+
+    def LogLevelGet(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def LogLevelSet(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+
+def add_LogServiceServicer_to_server(servicer, server):
+    rpc_method_handlers = {
+            'LogLevelGet': grpc.unary_unary_rpc_method_handler(
+                    servicer.LogLevelGet,
+                    request_deserializer=google_dot_protobuf_dot_empty__pb2.Empty.FromString,
+                    response_serializer=github_dot_com_dot_longhorn_dot_longhorn__engine_dot_proto_dot_ptypes_dot_common__pb2.LogLevel.SerializeToString,
+            ),
+            'LogLevelSet': grpc.unary_unary_rpc_method_handler(
+                    servicer.LogLevelSet,
+                    request_deserializer=github_dot_com_dot_longhorn_dot_longhorn__engine_dot_proto_dot_ptypes_dot_common__pb2.LogLevel.FromString,
+                    response_serializer=github_dot_com_dot_longhorn_dot_longhorn__engine_dot_proto_dot_ptypes_dot_common__pb2.LogLevel.SerializeToString,
+            ),
+    }
+    generic_handler = grpc.method_handlers_generic_handler(
+            'ptypes.LogService', rpc_method_handlers)
+    server.add_generic_rpc_handlers((generic_handler,))
+
+
+ # This class is part of an EXPERIMENTAL API.
+class LogService(object):
+    """Missing associated documentation comment in .proto file."""
+
+    @staticmethod
+    def LogLevelGet(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/ptypes.LogService/LogLevelGet',
+            google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
+            github_dot_com_dot_longhorn_dot_longhorn__engine_dot_proto_dot_ptypes_dot_common__pb2.LogLevel.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def LogLevelSet(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/ptypes.LogService/LogLevelSet',
+            github_dot_com_dot_longhorn_dot_longhorn__engine_dot_proto_dot_ptypes_dot_common__pb2.LogLevel.SerializeToString,
+            github_dot_com_dot_longhorn_dot_longhorn__engine_dot_proto_dot_ptypes_dot_common__pb2.LogLevel.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
@@ -69,6 +69,34 @@ func onUsageError(c *cli.Context, err error, isSubcommand bool) error {
 	panic(fmt.Errorf("usage error, please check your command"))
 }
 
+func callerPrettyfier(f *runtime.Frame) (function string, file string) {
+	fileName := fmt.Sprintf("%s:%d", path.Base(f.File), f.Line)
+	funcName := path.Base(f.Function)
+	return funcName, fileName
+}
+
+func setupLogging(c *cli.Context) error {
+	switch format := c.GlobalString("log-format"); format {
+	case "text":
+	case "json":
+		logrus.SetFormatter(&logrus.JSONFormatter{
+			CallerPrettyfier: callerPrettyfier,
+		})
+	default:
+		return fmt.Errorf("unsupported log format: %v", format)
+	}
+
+	level, err := logrus.ParseLevel(c.GlobalString("log-level"))
+	if err != nil {
+		return err
+	}
+	if c.GlobalBool("debug") {
+		level = logrus.DebugLevel
+	}
+	logrus.SetLevel(level)
+	return nil
+}
+
 func longhornCli() {
 	pprofFile := os.Getenv("PPROFILE")
 	if pprofFile != "" {
@@ -89,19 +117,16 @@ func longhornCli() {
 
 	logrus.SetReportCaller(true)
 	logrus.SetFormatter(&logrus.TextFormatter{
-		CallerPrettyfier: func(f *runtime.Frame) (function string, file string) {
-			fileName := fmt.Sprintf("%s:%d", path.Base(f.File), f.Line)
-			funcName := path.Base(f.Function)
-			return funcName, fileName
-		},
-		FullTimestamp: true,
+		CallerPrettyfier: callerPrettyfier,
+		FullTimestamp:    true,
 	})
 
 	a.Before = func(c *cli.Context) error {
-		if c.GlobalBool("debug") {
-			logrus.SetLevel(logrus.DebugLevel)
+		if err := setupLogging(c); err != nil {
+			return err
 		}
-		return nil
+		cmd.InitControlToken(c)
+		return cmd.InitGRPCTLS(c)
 	}
 	a.Flags = []cli.Flag{
 		cli.StringFlag{
@@ -121,6 +146,41 @@ func longhornCli() {
 		cli.BoolFlag{
 			Name: "debug",
 		},
+		cli.StringFlag{
+			Name:  "log-level",
+			Value: logrus.InfoLevel.String(),
+			Usage: "Log level: panic, fatal, error, warning, info, debug or trace. --debug overrides it. It can be changed at runtime through the debug listener",
+		},
+		cli.StringFlag{
+			Name:  "log-format",
+			Value: "text",
+			Usage: "Log format: text or json",
+		},
+		cli.StringFlag{
+			Name:  "grpc-tls-cert",
+			Usage: "Certificate file for the gRPC servers of this process, and client certificate for its gRPC clients",
+		},
+		cli.StringFlag{
+			Name:  "grpc-tls-key",
+			Usage: "Key file matching grpc-tls-cert",
+		},
+		cli.StringFlag{
+			Name:  "grpc-tls-ca",
+			Usage: "CA file to verify gRPC peers with. gRPC servers require client certificates signed by it (mutual TLS)",
+		},
+		cli.StringFlag{
+			Name:  "grpc-tls-server-name",
+			Usage: "Server name expected in gRPC server certificates instead of the dialed host",
+		},
+		cli.StringFlag{
+			Name:   "control-token",
+			EnvVar: cmd.ControlTokenEnv,
+			Usage:  "Token required by the gRPC servers of this process for mutating calls, and sent by its gRPC clients. Prefer the environment variable, command lines are visible to other local users",
+		},
+		cli.StringFlag{
+			Name:  "audit-log",
+			Usage: "File the gRPC servers of the controller, replica and sync-agent commands append every mutating call to, with its caller and result",
+		},
 	}
 	a.Commands = []cli.Command{
 		cmd.ControllerCmd(),
@@ -142,10 +202,14 @@ func longhornCli() {
 		cmd.ExpandCmd(),
 		cmd.UnmapMarkSnapChainRemovedCmd(),
 		cmd.Journal(),
+		cmd.AuditLogCmd(),
 		cmd.InfoCmd(),
+		cmd.EventsCmd(),
+		cmd.IOLimitsCmd(),
 		cmd.FrontendCmd(),
 		cmd.SystemBackupCmd(),
 		cmd.ProfilerCmd(),
+		cmd.LogLevelCmd(),
 		VersionCmd(),
 	}
 	a.CommandNotFound = cmdNotFound
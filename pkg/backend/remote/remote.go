@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"crypto/tls"
 	"fmt"
 	"net"
 	"strconv"
@@ -10,7 +11,6 @@ import (
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/longhorn/longhorn-engine/pkg/dataconn"
@@ -24,8 +24,12 @@ const (
 	PingInterval = 2 * time.Second
 )
 
-func New() types.BackendFactory {
-	return &Factory{}
+// New returns the factory of remote replica backends. If tlsConfig is not
+// nil, TCP data connections to the replicas are made over TLS.
+func New(tlsConfig *tls.Config) types.BackendFactory {
+	return &Factory{
+		tlsConfig: tlsConfig,
+	}
 }
 
 type RevisionCounter struct {
@@ -33,6 +37,7 @@ type RevisionCounter struct {
 }
 
 type Factory struct {
+	tlsConfig *tls.Config
 }
 
 type Remote struct {
@@ -46,7 +51,7 @@ type Remote struct {
 
 func (r *Remote) Close() error {
 	logrus.Infof("Closing: %s", r.name)
-	conn, err := grpc.Dial(r.replicaServiceURL, grpc.WithTransportCredentials(insecure.NewCredentials()),
+	conn, err := grpc.Dial(r.replicaServiceURL, ptypes.WithClientTransportCredentials(),
 		ptypes.WithIdentityValidationClientInterceptor(r.volumeName, ""))
 	if err != nil {
 		return errors.Wrapf(err, "cannot connect to ReplicaService %v", r.replicaServiceURL)
@@ -66,7 +71,7 @@ func (r *Remote) Close() error {
 
 func (r *Remote) open() error {
 	logrus.Infof("Opening remote: %s", r.name)
-	conn, err := grpc.Dial(r.replicaServiceURL, grpc.WithTransportCredentials(insecure.NewCredentials()),
+	conn, err := grpc.Dial(r.replicaServiceURL, ptypes.WithClientTransportCredentials(),
 		ptypes.WithIdentityValidationClientInterceptor(r.volumeName, ""))
 	if err != nil {
 		return errors.Wrapf(err, "cannot connect to ReplicaService %v", r.replicaServiceURL)
@@ -87,7 +92,7 @@ func (r *Remote) open() error {
 func (r *Remote) Snapshot(name string, userCreated bool, created string, labels map[string]string) error {
 	logrus.Infof("Starting to snapshot: %s %s UserCreated %v Created at %v, Labels %v",
 		r.name, name, userCreated, created, labels)
-	conn, err := grpc.Dial(r.replicaServiceURL, grpc.WithTransportCredentials(insecure.NewCredentials()),
+	conn, err := grpc.Dial(r.replicaServiceURL, ptypes.WithClientTransportCredentials(),
 		ptypes.WithIdentityValidationClientInterceptor(r.volumeName, ""))
 	if err != nil {
 		return errors.Wrapf(err, "cannot connect to ReplicaService %v", r.replicaServiceURL)
@@ -117,7 +122,7 @@ func (r *Remote) Expand(size int64) (err error) {
 		err = types.WrapError(err, "failed to expand replica %v from remote", r.replicaServiceURL)
 	}()
 
-	conn, err := grpc.Dial(r.replicaServiceURL, grpc.WithTransportCredentials(insecure.NewCredentials()),
+	conn, err := grpc.Dial(r.replicaServiceURL, ptypes.WithClientTransportCredentials(),
 		ptypes.WithIdentityValidationClientInterceptor(r.volumeName, ""))
 	if err != nil {
 		return errors.Wrapf(err, "cannot connect to ReplicaService %v", r.replicaServiceURL)
@@ -140,7 +145,7 @@ func (r *Remote) Expand(size int64) (err error) {
 func (r *Remote) SetRevisionCounter(counter int64) error {
 	logrus.Infof("Set revision counter of %s to : %v", r.name, counter)
 
-	conn, err := grpc.Dial(r.replicaServiceURL, grpc.WithTransportCredentials(insecure.NewCredentials()),
+	conn, err := grpc.Dial(r.replicaServiceURL, ptypes.WithClientTransportCredentials(),
 		ptypes.WithIdentityValidationClientInterceptor(r.volumeName, ""))
 	if err != nil {
 		return errors.Wrapf(err, "cannot connect to ReplicaService %v", r.replicaServiceURL)
@@ -256,7 +261,7 @@ func (r *Remote) GetUnmapMarkSnapChainRemoved() (bool, error) {
 func (r *Remote) SetUnmapMarkSnapChainRemoved(enabled bool) error {
 	logrus.Infof("Setting UnmapMarkSnapChainRemoved of %s to : %v", r.name, enabled)
 
-	conn, err := grpc.Dial(r.replicaServiceURL, grpc.WithTransportCredentials(insecure.NewCredentials()),
+	conn, err := grpc.Dial(r.replicaServiceURL, ptypes.WithClientTransportCredentials(),
 		ptypes.WithIdentityValidationClientInterceptor(r.volumeName, ""))
 	if err != nil {
 		return errors.Wrapf(err, "failed connecting to ReplicaService %v", r.replicaServiceURL)
@@ -288,7 +293,7 @@ func (r *Remote) ResetRebuild() error {
 
 	logrus.Warnf("Resetting %v rebuild", r.name)
 
-	conn, err := grpc.Dial(r.replicaServiceURL, grpc.WithTransportCredentials(insecure.NewCredentials()),
+	conn, err := grpc.Dial(r.replicaServiceURL, ptypes.WithClientTransportCredentials(),
 		ptypes.WithIdentityValidationClientInterceptor(r.volumeName, ""))
 	if err != nil {
 		return errors.Wrapf(err, "failed connecting to ReplicaService %v", r.replicaServiceURL)
@@ -314,7 +319,7 @@ func (r *Remote) SetSnapshotMaxCount(count int) error {
 	logrus.Infof("Setting SnapshotMaxCount of %s to : %d", r.name, count)
 
 	conn, err := grpc.Dial(r.replicaServiceURL,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		ptypes.WithClientTransportCredentials(),
 		ptypes.WithIdentityValidationClientInterceptor(r.volumeName, ""))
 	if err != nil {
 		return errors.Wrapf(err, "cannot connect to ReplicaService %s", r.replicaServiceURL)
@@ -338,7 +343,7 @@ func (r *Remote) SetSnapshotMaxSize(size int64) error {
 	logrus.Infof("Setting SnapshotMaxSize of %s to : %d", r.name, size)
 
 	conn, err := grpc.Dial(r.replicaServiceURL,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		ptypes.WithClientTransportCredentials(),
 		ptypes.WithIdentityValidationClientInterceptor(r.volumeName, ""))
 	if err != nil {
 		return errors.Wrapf(err, "cannot connect to ReplicaService %s", r.replicaServiceURL)
@@ -359,7 +364,7 @@ func (r *Remote) SetSnapshotMaxSize(size int64) error {
 }
 
 func (r *Remote) info() (*types.ReplicaInfo, error) {
-	conn, err := grpc.Dial(r.replicaServiceURL, grpc.WithTransportCredentials(insecure.NewCredentials()),
+	conn, err := grpc.Dial(r.replicaServiceURL, ptypes.WithClientTransportCredentials(),
 		ptypes.WithIdentityValidationClientInterceptor(r.volumeName, ""))
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot connect to ReplicaService %v", r.replicaServiceURL)
@@ -405,7 +410,7 @@ func (rf *Factory) Create(volumeName, address string, dataServerProtocol types.D
 		return nil, fmt.Errorf("replica must be closed, cannot add in state: %s", replica.State)
 	}
 
-	conn, err := connect(dataServerProtocol, dataAddress)
+	conn, err := connect(dataServerProtocol, dataAddress, rf.tlsConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -422,9 +427,12 @@ func (rf *Factory) Create(volumeName, address string, dataServerProtocol types.D
 	return r, nil
 }
 
-func connect(dataServerProtocol types.DataServerProtocol, address string) (net.Conn, error) {
+func connect(dataServerProtocol types.DataServerProtocol, address string, tlsConfig *tls.Config) (net.Conn, error) {
 	switch dataServerProtocol {
 	case types.DataServerProtocolTCP:
+		if tlsConfig != nil {
+			return tls.Dial(string(dataServerProtocol), address, tlsConfig)
+		}
 		return net.Dial(string(dataServerProtocol), address)
 	case types.DataServerProtocolUNIX:
 		unixAddr, err := net.ResolveUnixAddr("unix", address)
@@ -7,7 +7,6 @@ import (
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -46,8 +45,9 @@ const (
 
 func NewControllerClient(address, volumeName, instanceName string) (*ControllerClient, error) {
 	getControllerServiceContext := func(serviceUrl string) (ControllerServiceContext, error) {
-		connection, err := grpc.Dial(serviceUrl, grpc.WithTransportCredentials(insecure.NewCredentials()),
-			ptypes.WithIdentityValidationClientInterceptor(volumeName, instanceName))
+		connection, err := grpc.Dial(serviceUrl, ptypes.WithClientTransportCredentials(),
+			ptypes.WithIdentityValidationClientInterceptor(volumeName, instanceName),
+			ptypes.WithIdentityValidationStreamClientInterceptor(volumeName, instanceName))
 		if err != nil {
 			return ControllerServiceContext{}, errors.Wrapf(err, "cannot connect to ControllerService %v", serviceUrl)
 		}
@@ -85,6 +85,8 @@ func GetVolumeInfo(v *ptypes.Volume) *types.VolumeInfo {
 		UnmapMarkSnapChainRemoved: v.UnmapMarkSnapChainRemoved,
 		SnapshotMaxCount:          int(v.SnapshotMaxCount),
 		SnapshotMaxSize:           v.SnapshotMaxSize,
+		IOPSLimit:                 v.IopsLimit,
+		BandwidthLimit:            v.BandwidthLimit,
 	}
 }
 
@@ -104,6 +106,16 @@ func GetControllerReplica(r *types.ControllerReplicaInfo) *ptypes.ControllerRepl
 	}
 }
 
+func GetVolumeEvent(e *ptypes.VolumeEvent) *types.VolumeEvent {
+	return &types.VolumeEvent{
+		Type:           e.Type.String(),
+		VolumeName:     e.VolumeName,
+		ReplicaAddress: e.ReplicaAddress,
+		Message:        e.Message,
+		Time:           e.Time,
+	}
+}
+
 func GetSyncFileInfoList(list []*ptypes.SyncFileInfo) []types.SyncFileInfo {
 	res := []types.SyncFileInfo{}
 	for _, info := range list {
@@ -261,6 +273,21 @@ func (c *ControllerClient) VolumeSnapshotMaxSizeSet(size int64) error {
 	return nil
 }
 
+func (c *ControllerClient) VolumeIOLimitsSet(iops, bandwidth int64) error {
+	controllerServiceClient := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), GRPCServiceTimeout)
+	defer cancel()
+
+	if _, err := controllerServiceClient.VolumeIOLimitsSet(ctx, &ptypes.VolumeIOLimitsSetRequest{
+		Iops:      iops,
+		Bandwidth: bandwidth,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to set IO limits to %d IOPS and %d bytes/s for volume %s", iops, bandwidth, c.serviceURL)
+	}
+
+	return nil
+}
+
 func (c *ControllerClient) ReplicaList() ([]*types.ControllerReplicaInfo, error) {
 	controllerServiceClient := c.getControllerServiceClient()
 	ctx, cancel := context.WithTimeout(context.Background(), GRPCServiceTimeout)
@@ -386,6 +413,27 @@ func (c *ControllerClient) JournalList(limit int) error {
 	return nil
 }
 
+// VolumeEventWatch calls handler with each event of the volume, until ctx is
+// done, the stream breaks or handler returns an error.
+func (c *ControllerClient) VolumeEventWatch(ctx context.Context, handler func(*types.VolumeEvent) error) error {
+	controllerServiceClient := c.getControllerServiceClient()
+
+	stream, err := controllerServiceClient.VolumeEventWatch(ctx, &emptypb.Empty{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to watch events of volume %v", c.serviceURL)
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			return errors.Wrapf(err, "failed to receive event of volume %v", c.serviceURL)
+		}
+		if err := handler(GetVolumeEvent(event)); err != nil {
+			return err
+		}
+	}
+}
+
 func (c *ControllerClient) VersionDetailGet() (*meta.VersionOutput, error) {
 	controllerServiceClient := c.getControllerServiceClient()
 	ctx, cancel := context.WithTimeout(context.Background(), GRPCServiceTimeout)
@@ -411,7 +459,7 @@ func (c *ControllerClient) VersionDetailGet() (*meta.VersionOutput, error) {
 }
 
 func (c *ControllerClient) Check() error {
-	conn, err := grpc.Dial(c.serviceURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.Dial(c.serviceURL, ptypes.WithClientTransportCredentials())
 	if err != nil {
 		return errors.Wrapf(err, "cannot connect to ControllerService %v", c.serviceURL)
 	}
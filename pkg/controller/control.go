@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -25,17 +26,17 @@ import (
 
 type Controller struct {
 	sync.RWMutex
-	VolumeName                string
-	size                      int64
-	sectorSize                int64
-	replicas                  []types.Replica
-	factory                   types.BackendFactory
-	backend                   *replicator
-	frontend                  types.Frontend
-	isUpgrade                 bool
-	iscsiTargetRequestTimeout time.Duration
-	engineReplicaTimeout      time.Duration
-	DataServerProtocol        types.DataServerProtocol
+	VolumeName           string
+	size                 int64
+	sectorSize           int64
+	replicas             []types.Replica
+	factory              types.BackendFactory
+	backend              *replicator
+	frontend             types.Frontend
+	isUpgrade            bool
+	frontendOptions      FrontendOptions
+	engineReplicaTimeout time.Duration
+	DataServerProtocol   types.DataServerProtocol
 
 	isExpanding             bool
 	revisionCounterDisabled bool
@@ -48,12 +49,21 @@ type Controller struct {
 	GRPCAddress string
 	GRPCServer  *grpc.Server
 
+	// ioLimits throttle the IO of the volume. They are swapped as a whole,
+	// so they can change while IO is in flight.
+	ioLimits atomic.Pointer[ioLimiters]
+
 	ShutdownWG sync.WaitGroup
 	lastError  error
 
+	eventLock        sync.Mutex
+	eventSubscribers map[chan *ptypes.VolumeEvent]struct{}
+
 	metricsLock   sync.RWMutex
 	latestMetrics *types.Metrics
 	metrics       *types.Metrics
+	// totalMetrics accumulates since start, for the Prometheus counters
+	totalMetrics types.Metrics
 
 	// lastExpansionFailedAt indicates if the error belongs to the recent expansion
 	lastExpansionFailedAt string
@@ -71,7 +81,7 @@ const (
 )
 
 func NewController(name string, factory types.BackendFactory, frontend types.Frontend, isUpgrade, disableRevCounter, salvageRequested, unmapMarkSnapChainRemoved bool,
-	iscsiTargetRequestTimeout, engineReplicaTimeout time.Duration, dataServerProtocol types.DataServerProtocol, fileSyncHTTPClientTimeout, snapshotMaxCount int, snapshotMaxSize int64) *Controller {
+	frontendOptions FrontendOptions, engineReplicaTimeout time.Duration, dataServerProtocol types.DataServerProtocol, fileSyncHTTPClientTimeout, snapshotMaxCount int, snapshotMaxSize int64) *Controller {
 	c := &Controller{
 		factory:       factory,
 		VolumeName:    name,
@@ -86,11 +96,13 @@ func NewController(name string, factory types.BackendFactory, frontend types.Fro
 		snapshotMaxCount:          snapshotMaxCount,
 		SnapshotMaxSize:           snapshotMaxSize,
 
-		iscsiTargetRequestTimeout: iscsiTargetRequestTimeout,
-		engineReplicaTimeout:      engineReplicaTimeout,
-		DataServerProtocol:        dataServerProtocol,
+		frontendOptions:      frontendOptions,
+		engineReplicaTimeout: engineReplicaTimeout,
+		DataServerProtocol:   dataServerProtocol,
 
 		fileSyncHTTPClientTimeout: fileSyncHTTPClientTimeout,
+
+		eventSubscribers: map[chan *ptypes.VolumeEvent]struct{}{},
 	}
 	c.reset()
 	c.metricsStart()
@@ -402,6 +414,9 @@ func (c *Controller) addReplicaNoLock(newBackend types.Backend, address string,
 	if mode != types.ERR {
 		go c.monitoring(address, newBackend)
 	}
+	if mode == types.WO {
+		c.publishEvent(ptypes.VolumeEventType_REBUILD_STARTED, address, "")
+	}
 
 	return nil
 }
@@ -461,9 +476,13 @@ func (c *Controller) setReplicaModeNoLock(address string, mode types.Mode) {
 		if r.Address == address {
 			if r.Mode != types.ERR {
 				logrus.Infof("Setting replica %v to mode %v", address, mode)
+				oldMode := r.Mode
 				r.Mode = mode
 				c.replicas[i] = r
 				c.backend.SetMode(address, mode)
+				if mode == types.ERR {
+					c.publishFaultEvents(address, oldMode)
+				}
 			} else {
 				logrus.Infof("Ignore set replica %v to mode %v due to it's ERR", address, mode)
 			}
@@ -471,6 +490,24 @@ func (c *Controller) setReplicaModeNoLock(address string, mode types.Mode) {
 	}
 }
 
+// publishFaultEvents reports a replica that was in oldMode going to ERR.
+func (c *Controller) publishFaultEvents(address string, oldMode types.Mode) {
+	message := fmt.Sprintf("replica was in mode %v", oldMode)
+	c.publishEvent(ptypes.VolumeEventType_BACKEND_FAULTED, address, message)
+	if oldMode == types.WO {
+		c.publishEvent(ptypes.VolumeEventType_REBUILD_FAILED, address, message)
+	}
+	if oldMode != types.RW {
+		return
+	}
+	for _, r := range c.replicas {
+		if r.Mode == types.RW {
+			return
+		}
+	}
+	c.publishEvent(ptypes.VolumeEventType_VOLUME_FAULTED, address, "no replica left in mode RW")
+}
+
 func (c *Controller) startFrontend() error {
 	if len(c.replicas) > 0 && c.frontend != nil {
 		if c.isUpgrade {
@@ -510,7 +547,7 @@ func (c *Controller) StartFrontend(frontend string) error {
 		}
 	}
 
-	f, err := NewFrontend(frontend, c.iscsiTargetRequestTimeout)
+	f, err := NewFrontend(frontend, c.frontendOptions)
 	if err != nil {
 		return errors.Wrapf(err, "failed to find frontend: %s", frontend)
 	}
@@ -965,14 +1002,84 @@ func (c *Controller) Start(volumeSize, volumeCurrentSize int64, addresses ...str
 	return c.startFrontend()
 }
 
-func (c *Controller) WriteAt(b []byte, off int64) (int, error) {
+// ioLimiters holds the limiters of the volume. A limiter is nil when unlimited.
+type ioLimiters struct {
+	iops             int64
+	bandwidth        int64
+	iopsLimiter      *util.TokenBucket
+	bandwidthLimiter *util.TokenBucket
+}
+
+// SetIOLimits limits the IO of the volume to iops operations and bandwidth
+// bytes per second. Zero means unlimited. Reads, writes and unmaps count as
+// operations, only reads and writes count against the bandwidth. It can be
+// called while IO is in flight, which then finishes under the old limits.
+func (c *Controller) SetIOLimits(iops, bandwidth int64) error {
+	if iops < 0 || bandwidth < 0 {
+		return fmt.Errorf("invalid IO limits %v IOPS and %v bytes per second", iops, bandwidth)
+	}
+
+	limits := &ioLimiters{
+		iops:      iops,
+		bandwidth: bandwidth,
+	}
+	if iops > 0 {
+		limits.iopsLimiter = util.NewTokenBucket(iops, iops)
+	}
+	if bandwidth > 0 {
+		limits.bandwidthLimiter = util.NewTokenBucket(bandwidth, bandwidth)
+	}
+	c.ioLimits.Store(limits)
+
+	logrus.Infof("Set IO limits of volume %v to %v IOPS and %v bytes per second", c.VolumeName, iops, bandwidth)
+	return nil
+}
+
+// GetIOLimits returns the IOPS and bandwidth limits of the volume.
+func (c *Controller) GetIOLimits() (int64, int64) {
+	limits := c.ioLimits.Load()
+	if limits == nil {
+		return 0, 0
+	}
+	return limits.iops, limits.bandwidth
+}
+
+// throttleIO waits for the IO limits of the volume, for one operation of
+// length bytes. It must be called without holding the controller lock, so
+// throttled IO does not hold off snapshots or replica changes.
+func (c *Controller) throttleIO(length int) {
+	limits := c.ioLimits.Load()
+	if limits == nil {
+		return
+	}
+	if limits.iopsLimiter != nil {
+		limits.iopsLimiter.Wait(1)
+	}
+	if limits.bandwidthLimiter != nil && length > 0 {
+		limits.bandwidthLimiter.Wait(int64(length))
+	}
+}
+
+// checkIORange runs before throttleIO, so that requests beyond the volume fail
+// right away rather than after waiting for the limiters. The volume only ever
+// grows, so the range is still valid once the lock is taken again for the IO.
+func (c *Controller) checkIORange(op string, length int, off int64) error {
 	c.RLock()
+	defer c.RUnlock()
+
+	if off < 0 || off+int64(length) > c.size {
+		return fmt.Errorf("EOF: %v of %v bytes at offset %v is beyond volume size %v", op, length, off, c.size)
+	}
+	return nil
+}
+
+func (c *Controller) WriteAt(b []byte, off int64) (int, error) {
 	l := len(b)
-	if off < 0 || off+int64(l) > c.size {
-		err := fmt.Errorf("EOF: Write of %v bytes at offset %v is beyond volume size %v", l, off, c.size)
-		c.RUnlock()
+	if err := c.checkIORange("Write", l, off); err != nil {
 		return 0, err
 	}
+	c.throttleIO(l)
+	c.RLock()
 	startTime := time.Now()
 	var n int
 	var err error
@@ -1028,13 +1135,12 @@ func (c *Controller) writeInNormalMode(b []byte, off int64) (int, error) {
 }
 
 func (c *Controller) ReadAt(b []byte, off int64) (int, error) {
-	c.RLock()
 	l := len(b)
-	if off < 0 || off+int64(l) > c.size {
-		err := fmt.Errorf("EOF: Read of %v bytes at offset %v is beyond volume size %v", l, off, c.size)
-		c.RUnlock()
+	if err := c.checkIORange("Read", l, off); err != nil {
 		return 0, err
 	}
+	c.throttleIO(l)
+	c.RLock()
 	startTime := time.Now()
 	n, err := c.backend.ReadAt(b, off)
 	c.RUnlock()
@@ -1048,16 +1154,16 @@ func (c *Controller) ReadAt(b []byte, off int64) (int, error) {
 func (c *Controller) UnmapAt(length uint32, off int64) (int, error) {
 	// TODO: Need to fail unmap requests
 	//  if the volume is purging snapshots or creating backups.
-	c.RLock()
-
-	if off < 0 || off+int64(length) > c.size {
+	if err := c.checkIORange("unmap", int(length), off); err != nil {
 		// This is a legitimate error (which is handled by tgt/liblonghorn at a higher level). Since tgt/liblonghorn
 		// does not actually print the error, print it here.
-		err := fmt.Errorf("EOF: unmap of %v bytes at offset %v is beyond volume size %v", length, off, c.size)
 		logrus.WithError(err).Error("Failed to unmap")
-		c.RUnlock()
 		return 0, err
 	}
+	// Unmap carries no data, so it only counts against the IOPS limit
+	c.throttleIO(0)
+	c.RLock()
+
 	if c.hasWOReplica() {
 		// Cannot unmap during rebuilding. See https://github.com/longhorn/longhorn/issues/7103 for context. It is legal
 		// to not complete the unmap operation, so simply respond that 0 blocks were unmapped. Otherwise, VM workloads
@@ -1228,6 +1334,27 @@ func (c *Controller) Frontend() string {
 	return ""
 }
 
+// Health returns the health of the volume based on the replica modes.
+func (c *Controller) Health() types.VolumeHealth {
+	c.RLock()
+	defer c.RUnlock()
+
+	rwReplicaCount := 0
+	for _, r := range c.replicas {
+		if r.Mode == types.RW {
+			rwReplicaCount++
+		}
+	}
+	switch {
+	case rwReplicaCount == 0:
+		return types.VolumeHealthFaulted
+	case rwReplicaCount < len(c.replicas):
+		return types.VolumeHealthDegraded
+	default:
+		return types.VolumeHealthHealthy
+	}
+}
+
 func (c *Controller) FrontendState() string {
 	if c.frontend != nil {
 		return string(c.frontend.State())
@@ -1239,14 +1366,16 @@ func (c *Controller) recordMetrics(isRead bool, dataLength int, latency time.Dur
 	c.metricsLock.Lock()
 	defer c.metricsLock.Unlock()
 
-	if isRead {
-		c.metrics.Throughput.Read += uint64(dataLength)
-		c.metrics.TotalLatency.Read += uint64(latency.Nanoseconds())
-		c.metrics.IOPS.Read++
-	} else {
-		c.metrics.Throughput.Write += uint64(dataLength)
-		c.metrics.TotalLatency.Write += uint64(latency.Nanoseconds())
-		c.metrics.IOPS.Write++
+	for _, metrics := range []*types.Metrics{c.metrics, &c.totalMetrics} {
+		if isRead {
+			metrics.Throughput.Read += uint64(dataLength)
+			metrics.TotalLatency.Read += uint64(latency.Nanoseconds())
+			metrics.IOPS.Read++
+		} else {
+			metrics.Throughput.Write += uint64(dataLength)
+			metrics.TotalLatency.Write += uint64(latency.Nanoseconds())
+			metrics.IOPS.Write++
+		}
 	}
 }
 
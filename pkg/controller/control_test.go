@@ -4,11 +4,15 @@ import (
 	"io"
 	"reflect"
 	"testing"
+	"time"
 
 	. "gopkg.in/check.v1"
 
+	devtypes "github.com/longhorn/go-iscsi-helper/types"
+
 	"github.com/longhorn/longhorn-engine/pkg/types"
 	diskutil "github.com/longhorn/longhorn-engine/pkg/util/disk"
+	"github.com/longhorn/longhorn-engine/proto/ptypes"
 )
 
 func Test(t *testing.T) { TestingT(t) }
@@ -302,3 +306,127 @@ func resetSlice(data []byte, val byte) {
 		data[i] = val
 	}
 }
+
+func (s *TestSuite) TestHealth(c *C) {
+	control := &Controller{}
+	c.Assert(control.Health(), Equals, types.VolumeHealthFaulted)
+
+	control.replicas = []types.Replica{
+		{Address: "tcp://replica-1:9502", Mode: types.RW},
+		{Address: "tcp://replica-2:9502", Mode: types.RW},
+	}
+	c.Assert(control.Health(), Equals, types.VolumeHealthHealthy)
+
+	control.replicas[1].Mode = types.WO
+	c.Assert(control.Health(), Equals, types.VolumeHealthDegraded)
+
+	control.replicas[0].Mode = types.ERR
+	c.Assert(control.Health(), Equals, types.VolumeHealthFaulted)
+}
+
+func (s *TestSuite) TestNewFrontend512e(c *C) {
+	for _, frontendType := range []string{"rest", "socket", "nbd"} {
+		_, err := NewFrontend(frontendType, FrontendOptions{Emulate512e: true})
+		c.Assert(err, NotNil, Commentf(frontendType))
+
+		f, err := NewFrontend(frontendType, FrontendOptions{})
+		c.Assert(err, IsNil, Commentf(frontendType))
+		c.Assert(f.FrontendName(), Equals, frontendType)
+	}
+	for _, frontendType := range []string{devtypes.FrontendTGTBlockDev, devtypes.FrontendTGTISCSI} {
+		f, err := NewFrontend(frontendType, FrontendOptions{Emulate512e: true})
+		c.Assert(err, IsNil, Commentf(frontendType))
+		c.Assert(f.FrontendName(), Equals, frontendType)
+	}
+}
+
+func (s *TestSuite) TestOutOfRangeIONotThrottled(c *C) {
+	control := &Controller{size: 4096}
+	// One IO per second, so a throttled request would take about a second
+	c.Assert(control.SetIOLimits(1, 0), IsNil)
+
+	startTime := time.Now()
+	for i := 0; i < 5; i++ {
+		_, err := control.WriteAt(make([]byte, 512), 4096)
+		c.Assert(err, NotNil)
+		_, err = control.ReadAt(make([]byte, 512), -512)
+		c.Assert(err, NotNil)
+		_, err = control.UnmapAt(512, 4096)
+		c.Assert(err, NotNil)
+	}
+	c.Assert(time.Since(startTime) < 500*time.Millisecond, Equals, true)
+}
+
+func (s *TestSuite) TestSetIOLimits(c *C) {
+	control := &Controller{size: 4096}
+	iops, bandwidth := control.GetIOLimits()
+	c.Assert(iops, Equals, int64(0))
+	c.Assert(bandwidth, Equals, int64(0))
+
+	c.Assert(control.SetIOLimits(-1, 0), NotNil)
+	c.Assert(control.SetIOLimits(0, -1), NotNil)
+
+	c.Assert(control.SetIOLimits(100, 4096), IsNil)
+	iops, bandwidth = control.GetIOLimits()
+	c.Assert(iops, Equals, int64(100))
+	c.Assert(bandwidth, Equals, int64(4096))
+
+	c.Assert(control.SetIOLimits(0, 0), IsNil)
+	iops, bandwidth = control.GetIOLimits()
+	c.Assert(iops, Equals, int64(0))
+	c.Assert(bandwidth, Equals, int64(0))
+}
+
+func (s *TestSuite) TestFaultEvents(c *C) {
+	control := &Controller{
+		VolumeName:       "test-volume",
+		backend:          &replicator{},
+		eventSubscribers: map[chan *ptypes.VolumeEvent]struct{}{},
+		replicas: []types.Replica{
+			{Address: "tcp://replica-1:9502", Mode: types.RW},
+			{Address: "tcp://replica-2:9502", Mode: types.WO},
+		},
+	}
+	events, cancel := control.SubscribeEvents()
+
+	c.Assert(control.SetReplicaMode("tcp://replica-2:9502", types.ERR), IsNil)
+	c.Assert(control.SetReplicaMode("tcp://replica-1:9502", types.ERR), IsNil)
+	// A replica in ERR stays there, so this is not reported again
+	c.Assert(control.SetReplicaMode("tcp://replica-1:9502", types.ERR), IsNil)
+	cancel()
+
+	type event struct {
+		eventType ptypes.VolumeEventType
+		address   string
+	}
+	received := []event{}
+	for e := range events {
+		c.Assert(e.VolumeName, Equals, "test-volume")
+		received = append(received, event{e.Type, e.ReplicaAddress})
+	}
+	c.Assert(received, DeepEquals, []event{
+		{ptypes.VolumeEventType_BACKEND_FAULTED, "tcp://replica-2:9502"},
+		{ptypes.VolumeEventType_REBUILD_FAILED, "tcp://replica-2:9502"},
+		{ptypes.VolumeEventType_BACKEND_FAULTED, "tcp://replica-1:9502"},
+		{ptypes.VolumeEventType_VOLUME_FAULTED, "tcp://replica-1:9502"},
+	})
+}
+
+func (s *TestSuite) TestSlowEventSubscriberDropped(c *C) {
+	control := &Controller{
+		eventSubscribers: map[chan *ptypes.VolumeEvent]struct{}{},
+	}
+	events, cancel := control.SubscribeEvents()
+	defer cancel()
+
+	for i := 0; i <= eventSubscriberBacklog; i++ {
+		control.publishEvent(ptypes.VolumeEventType_REBUILD_STARTED, "tcp://replica-1:9502", "")
+	}
+
+	count := 0
+	for range events {
+		count++
+	}
+	c.Assert(count, Equals, eventSubscriberBacklog)
+	c.Assert(control.eventSubscribers, HasLen, 0)
+}
@@ -0,0 +1,62 @@
+package controller
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/longhorn/longhorn-engine/proto/ptypes"
+)
+
+const (
+	// eventSubscriberBacklog is how many events a subscriber may fall behind
+	// before it is dropped.
+	eventSubscriberBacklog = 64
+)
+
+// SubscribeEvents returns a channel receiving the events of the volume from
+// now on, and a function ending the subscription. The channel is closed when
+// the subscription ends, or when the subscriber falls too far behind, in which
+// case it has to resync from the volume state.
+func (c *Controller) SubscribeEvents() (<-chan *ptypes.VolumeEvent, func()) {
+	c.eventLock.Lock()
+	defer c.eventLock.Unlock()
+
+	events := make(chan *ptypes.VolumeEvent, eventSubscriberBacklog)
+	c.eventSubscribers[events] = struct{}{}
+
+	return events, func() {
+		c.eventLock.Lock()
+		defer c.eventLock.Unlock()
+
+		if _, ok := c.eventSubscribers[events]; ok {
+			delete(c.eventSubscribers, events)
+			close(events)
+		}
+	}
+}
+
+// publishEvent sends an event to every subscriber without blocking, so it is
+// safe to call with the controller lock held.
+func (c *Controller) publishEvent(eventType ptypes.VolumeEventType, address, message string) {
+	event := &ptypes.VolumeEvent{
+		Type:           eventType,
+		VolumeName:     c.VolumeName,
+		ReplicaAddress: address,
+		Message:        message,
+		Time:           time.Now().UTC().Format(time.RFC3339Nano),
+	}
+
+	c.eventLock.Lock()
+	defer c.eventLock.Unlock()
+
+	for events := range c.eventSubscribers {
+		select {
+		case events <- event:
+		default:
+			logrus.Warnf("Dropping volume event subscriber that fell %v events behind", eventSubscriberBacklog)
+			delete(c.eventSubscribers, events)
+			close(events)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	devtypes "github.com/longhorn/go-iscsi-helper/types"
+	"github.com/longhorn/longhorn-engine/pkg/frontend/nbd"
 	"github.com/longhorn/longhorn-engine/pkg/frontend/rest"
 	"github.com/longhorn/longhorn-engine/pkg/frontend/socket"
 	"github.com/longhorn/longhorn-engine/pkg/frontend/tgt"
@@ -21,16 +22,36 @@ const (
 	maxEngineReplicaTimeout     = 30 * time.Second
 )
 
-func NewFrontend(frontendType string, iscsiTargetRequestTimeout time.Duration) (types.Frontend, error) {
+// FrontendOptions configures the frontend of the controller. Options that do
+// not apply to a frontend type are ignored by it.
+type FrontendOptions struct {
+	// ISCSITargetRequestTimeout is the timeout of the tgt frontends
+	ISCSITargetRequestTimeout time.Duration
+	// NBDListenAddress is the address the nbd frontend listens on
+	NBDListenAddress string
+	// ISCSIChap configures CHAP authentication of the tgt-iscsi frontend
+	ISCSIChap *tgt.ChapCredentials
+	// Emulate512e makes the tgt frontends report 4096-byte physical sectors
+	Emulate512e bool
+}
+
+func NewFrontend(frontendType string, opts FrontendOptions) (types.Frontend, error) {
+	if opts.Emulate512e && frontendType != devtypes.FrontendTGTBlockDev && frontendType != devtypes.FrontendTGTISCSI {
+		return nil, fmt.Errorf("512e emulation is only supported by frontends %v and %v",
+			devtypes.FrontendTGTBlockDev, devtypes.FrontendTGTISCSI)
+	}
+
 	switch frontendType {
 	case "rest":
 		return rest.New(), nil
 	case "socket":
 		return socket.New(), nil
+	case "nbd":
+		return nbd.New(opts.NBDListenAddress), nil
 	case devtypes.FrontendTGTBlockDev:
-		return tgt.New(devtypes.FrontendTGTBlockDev, defaultScsiTimeout, defaultIscsiAbortTimeout, iscsiTargetRequestTimeout), nil
+		return tgt.New(devtypes.FrontendTGTBlockDev, defaultScsiTimeout, defaultIscsiAbortTimeout, opts.ISCSITargetRequestTimeout, opts.ISCSIChap, opts.Emulate512e), nil
 	case devtypes.FrontendTGTISCSI:
-		return tgt.New(devtypes.FrontendTGTISCSI, defaultScsiTimeout, defaultIscsiAbortTimeout, iscsiTargetRequestTimeout), nil
+		return tgt.New(devtypes.FrontendTGTISCSI, defaultScsiTimeout, defaultIscsiAbortTimeout, opts.ISCSITargetRequestTimeout, opts.ISCSIChap, opts.Emulate512e), nil
 	default:
 		return nil, fmt.Errorf("unsupported frontend type: %v", frontendType)
 	}
@@ -0,0 +1,183 @@
+package controller
+
+import (
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/expfmt"
+	"github.com/sirupsen/logrus"
+
+	"github.com/longhorn/longhorn-engine/pkg/types"
+)
+
+const (
+	metricsNamespace = "longhorn_engine"
+)
+
+var (
+	ioOperationsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "io", "operations_total"),
+		"Number of IO operations served by the controller",
+		[]string{"volume", "io"}, nil)
+	ioBytesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "io", "bytes_total"),
+		"Number of bytes served by the controller",
+		[]string{"volume", "io"}, nil)
+	ioLatencyDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "io", "latency_seconds_total"),
+		"Sum of the latencies of the IO operations served by the controller",
+		[]string{"volume", "io"}, nil)
+	ioOperationsRateDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "io", "operations_per_second"),
+		"IO operations served by the controller in the last second",
+		[]string{"volume", "io"}, nil)
+	ioBytesRateDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "io", "bytes_per_second"),
+		"Bytes served by the controller in the last second",
+		[]string{"volume", "io"}, nil)
+	ioAverageLatencyDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "io", "average_latency_seconds"),
+		"Average latency of the IO operations served by the controller in the last second",
+		[]string{"volume", "io"}, nil)
+	volumeSizeDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "volume", "size_bytes"),
+		"Size of the volume",
+		[]string{"volume"}, nil)
+	volumeExpandingDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "volume", "expanding"),
+		"Whether the volume is being expanded",
+		[]string{"volume"}, nil)
+	replicasDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "volume", "replicas"),
+		"Number of replicas of the volume by mode. WO replicas are being rebuilt",
+		[]string{"volume", "mode"}, nil)
+)
+
+type metricsCollector struct {
+	c *Controller
+}
+
+// NewMetricsCollector returns a Prometheus collector for the IO statistics,
+// the size and the replica modes of the volume served by c.
+func NewMetricsCollector(c *Controller) prometheus.Collector {
+	return &metricsCollector{c: c}
+}
+
+func (m *metricsCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range []*prometheus.Desc{
+		ioOperationsDesc, ioBytesDesc, ioLatencyDesc,
+		ioOperationsRateDesc, ioBytesRateDesc, ioAverageLatencyDesc,
+		volumeSizeDesc, volumeExpandingDesc, replicasDesc,
+	} {
+		ch <- desc
+	}
+}
+
+func (m *metricsCollector) Collect(ch chan<- prometheus.Metric) {
+	c := m.c
+
+	c.metricsLock.RLock()
+	total := c.totalMetrics
+	latest := *c.latestMetrics
+	c.metricsLock.RUnlock()
+
+	for _, io := range []struct {
+		name                          string
+		total, throughput, latency    uint64
+		latestTotal, latestThroughput uint64
+		latestLatency                 uint64
+	}{
+		{"read", total.IOPS.Read, total.Throughput.Read, total.TotalLatency.Read,
+			latest.IOPS.Read, latest.Throughput.Read, latest.TotalLatency.Read},
+		{"write", total.IOPS.Write, total.Throughput.Write, total.TotalLatency.Write,
+			latest.IOPS.Write, latest.Throughput.Write, latest.TotalLatency.Write},
+	} {
+		ch <- prometheus.MustNewConstMetric(ioOperationsDesc, prometheus.CounterValue, float64(io.total), c.VolumeName, io.name)
+		ch <- prometheus.MustNewConstMetric(ioBytesDesc, prometheus.CounterValue, float64(io.throughput), c.VolumeName, io.name)
+		ch <- prometheus.MustNewConstMetric(ioLatencyDesc, prometheus.CounterValue,
+			time.Duration(io.latency).Seconds(), c.VolumeName, io.name)
+		ch <- prometheus.MustNewConstMetric(ioOperationsRateDesc, prometheus.GaugeValue, float64(io.latestTotal), c.VolumeName, io.name)
+		ch <- prometheus.MustNewConstMetric(ioBytesRateDesc, prometheus.GaugeValue, float64(io.latestThroughput), c.VolumeName, io.name)
+		averageLatency := 0.0
+		if io.latestTotal != 0 {
+			averageLatency = time.Duration(getAverageLatency(io.latestLatency, io.latestTotal)).Seconds()
+		}
+		ch <- prometheus.MustNewConstMetric(ioAverageLatencyDesc, prometheus.GaugeValue, averageLatency, c.VolumeName, io.name)
+	}
+
+	c.RLock()
+	size := c.size
+	expanding := c.isExpanding
+	replicaCounts := map[types.Mode]int{types.RW: 0, types.WO: 0, types.ERR: 0}
+	for _, r := range c.replicas {
+		replicaCounts[r.Mode]++
+	}
+	c.RUnlock()
+
+	ch <- prometheus.MustNewConstMetric(volumeSizeDesc, prometheus.GaugeValue, float64(size), c.VolumeName)
+	expandingValue := 0.0
+	if expanding {
+		expandingValue = 1
+	}
+	ch <- prometheus.MustNewConstMetric(volumeExpandingDesc, prometheus.GaugeValue, expandingValue, c.VolumeName)
+	for mode, count := range replicaCounts {
+		ch <- prometheus.MustNewConstMetric(replicasDesc, prometheus.GaugeValue, float64(count), c.VolumeName, string(mode))
+	}
+}
+
+// NewMetricsHandler returns an HTTP handler exposing the metrics of gatherer
+// in the format negotiated with the scraper.
+func NewMetricsHandler(gatherer prometheus.Gatherer) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		families, err := gatherer.Gather()
+		if err != nil {
+			logrus.WithError(err).Warn("Failed to gather metrics")
+			if len(families) == 0 {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		}
+
+		format := expfmt.Negotiate(r.Header)
+		w.Header().Set("Content-Type", string(format))
+		encoder := expfmt.NewEncoder(w, format)
+		for _, family := range families {
+			if err := encoder.Encode(family); err != nil {
+				logrus.WithError(err).Warn("Failed to encode metrics")
+				return
+			}
+		}
+	})
+}
+
+// StartMetricsServer serves the controller metrics, along with the Go runtime
+// and process metrics, on address under /metrics in the background.
+func (c *Controller) StartMetricsServer(address string) error {
+	registry := prometheus.NewRegistry()
+	if err := registry.Register(NewMetricsCollector(c)); err != nil {
+		return err
+	}
+	if err := registry.Register(prometheus.NewGoCollector()); err != nil {
+		return err
+	}
+	if err := registry.Register(prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{})); err != nil {
+		return err
+	}
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return errors.Wrapf(err, "failed to listen on metrics address %v", address)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", NewMetricsHandler(registry))
+	go func() {
+		logrus.Infof("Listening on metrics server %v", listener.Addr())
+		err := http.Serve(listener, mux)
+		logrus.WithError(err).Warnf("Metrics server at %v is down", listener.Addr())
+	}()
+	return nil
+}
@@ -0,0 +1,50 @@
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	. "gopkg.in/check.v1"
+
+	"github.com/longhorn/longhorn-engine/pkg/types"
+)
+
+func (s *TestSuite) TestMetricsCollector(c *C) {
+	control := &Controller{
+		VolumeName: "test-volume",
+		size:       4096,
+		replicas: []types.Replica{
+			{Address: "tcp://replica-1:9502", Mode: types.RW},
+			{Address: "tcp://replica-2:9502", Mode: types.WO},
+		},
+		metrics:       &types.Metrics{},
+		latestMetrics: &types.Metrics{},
+	}
+	control.recordMetrics(true, 4096, time.Millisecond)
+	control.recordMetrics(true, 4096, time.Millisecond)
+	control.recordMetrics(false, 512, time.Millisecond)
+
+	registry := prometheus.NewRegistry()
+	c.Assert(registry.Register(NewMetricsCollector(control)), IsNil)
+
+	rec := httptest.NewRecorder()
+	NewMetricsHandler(registry).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	c.Assert(rec.Code, Equals, http.StatusOK)
+
+	body := rec.Body.String()
+	for _, line := range []string{
+		`longhorn_engine_io_operations_total{io="read",volume="test-volume"} 2`,
+		`longhorn_engine_io_operations_total{io="write",volume="test-volume"} 1`,
+		`longhorn_engine_io_bytes_total{io="read",volume="test-volume"} 8192`,
+		`longhorn_engine_io_latency_seconds_total{io="read",volume="test-volume"} 0.002`,
+		`longhorn_engine_volume_size_bytes{volume="test-volume"} 4096`,
+		`longhorn_engine_volume_replicas{mode="RW",volume="test-volume"} 1`,
+		`longhorn_engine_volume_replicas{mode="WO",volume="test-volume"} 1`,
+		`longhorn_engine_volume_replicas{mode="ERR",volume="test-volume"} 0`,
+	} {
+		c.Assert(strings.Contains(body, line+"\n"), Equals, true, Commentf("missing %q in:\n%v", line, body))
+	}
+}
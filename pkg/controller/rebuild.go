@@ -11,6 +11,7 @@ import (
 	"github.com/longhorn/longhorn-engine/pkg/replica/client"
 	"github.com/longhorn/longhorn-engine/pkg/types"
 	diskutil "github.com/longhorn/longhorn-engine/pkg/util/disk"
+	"github.com/longhorn/longhorn-engine/proto/ptypes"
 )
 
 func (c *Controller) getCurrentAndRWReplica(address string) (*types.Replica, *types.Replica, error) {
@@ -90,6 +91,7 @@ func (c *Controller) VerifyRebuildReplica(address, instanceName string) error {
 
 	logrus.Infof("WO replica %v's chain verified, update mode to RW", address)
 	c.setReplicaModeNoLock(address, types.RW)
+	c.publishEvent(ptypes.VolumeEventType_REBUILD_COMPLETED, address, "")
 	return nil
 }
 
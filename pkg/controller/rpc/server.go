@@ -1,13 +1,12 @@
 package rpc
 
 import (
-	"time"
-
-	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/longhorn/longhorn-engine/pkg/meta"
@@ -45,14 +44,22 @@ func NewControllerHealthCheckServer(cs *ControllerServer) *ControllerHealthCheck
 	}
 }
 
-func GetControllerGRPCServer(volumeName, instanceName string, c *controller.Controller) *grpc.Server {
+func GetControllerGRPCServer(volumeName, instanceName string, c *controller.Controller) (*grpc.Server, error) {
+	creds, err := ptypes.WithServerTransportCredentials()
+	if err != nil {
+		return nil, err
+	}
 	cs := NewControllerServer(c)
-	server := grpc.NewServer(ptypes.WithIdentityValidationControllerServerInterceptor(volumeName, instanceName))
+	server := grpc.NewServer(ptypes.WithAuditServerInterceptor(),
+		ptypes.WithIdentityValidationControllerServerInterceptor(volumeName, instanceName),
+		ptypes.WithIdentityValidationControllerStreamServerInterceptor(volumeName, instanceName),
+		ptypes.WithControlTokenServerInterceptor(), creds)
 	ptypes.RegisterControllerServiceServer(server, cs)
+	ptypes.RegisterLogServiceServer(server, ptypes.NewLogServer())
 	healthpb.RegisterHealthServer(server, NewControllerHealthCheckServer(cs))
 	reflection.Register(server)
 	profilerpb.RegisterProfilerServer(server, profiler.NewServer(volumeName))
-	return server
+	return server, nil
 }
 
 func (cs *ControllerServer) replicaToControllerReplica(r *types.Replica) *ptypes.ControllerReplica {
@@ -82,6 +89,7 @@ func (cs *ControllerServer) syncFileInfoToControllerFormat(info types.SyncFileIn
 
 func (cs *ControllerServer) getVolume() *ptypes.Volume {
 	lastExpansionError, lastExpansionFailedAt := cs.c.GetExpansionErrorInfo()
+	iopsLimit, bandwidthLimit := cs.c.GetIOLimits()
 	return &ptypes.Volume{
 		Name:                      cs.c.VolumeName,
 		Size:                      cs.c.Size(),
@@ -95,6 +103,8 @@ func (cs *ControllerServer) getVolume() *ptypes.Volume {
 		UnmapMarkSnapChainRemoved: cs.c.GetUnmapMarkSnapChainRemoved(),
 		SnapshotMaxCount:          int32(cs.c.GetSnapshotMaxCount()),
 		SnapshotMaxSize:           cs.c.GetSnapshotMaxSize(),
+		IopsLimit:                 iopsLimit,
+		BandwidthLimit:            bandwidthLimit,
 	}
 }
 
@@ -201,6 +211,14 @@ func (cs *ControllerServer) VolumeSnapshotMaxSizeSet(ctx context.Context, req *p
 	return cs.getVolume(), nil
 }
 
+func (cs *ControllerServer) VolumeIOLimitsSet(ctx context.Context, req *ptypes.VolumeIOLimitsSetRequest) (*ptypes.Volume, error) {
+	if err := cs.c.SetIOLimits(req.Iops, req.Bandwidth); err != nil {
+		return nil, err
+	}
+
+	return cs.getVolume(), nil
+}
+
 func (cs *ControllerServer) ReplicaList(ctx context.Context, req *emptypb.Empty) (*ptypes.ReplicaListReply, error) {
 	return &ptypes.ReplicaListReply{
 		Replicas: cs.listControllerReplica(),
@@ -284,36 +302,62 @@ func (cs *ControllerServer) MetricsGet(ctx context.Context, req *emptypb.Empty)
 	}, nil
 }
 
-func (hc *ControllerHealthCheckServer) Check(context.Context, *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
-	if hc.cs.c != nil {
-		return &healthpb.HealthCheckResponse{
-			Status: healthpb.HealthCheckResponse_SERVING,
-		}, nil
-	}
-
-	return &healthpb.HealthCheckResponse{
-		Status: healthpb.HealthCheckResponse_NOT_SERVING,
-	}, nil
-}
+func (cs *ControllerServer) VolumeEventWatch(req *emptypb.Empty, srv ptypes.ControllerService_VolumeEventWatchServer) error {
+	events, cancel := cs.c.SubscribeEvents()
+	defer cancel()
 
-func (hc *ControllerHealthCheckServer) Watch(req *healthpb.HealthCheckRequest, ws healthpb.Health_WatchServer) error {
 	for {
-		if hc.cs.c != nil {
-			if err := ws.Send(&healthpb.HealthCheckResponse{
-				Status: healthpb.HealthCheckResponse_SERVING,
-			}); err != nil {
-				logrus.WithError(err).Errorf("Failed to send health check result %v for gRPC controller server",
-					healthpb.HealthCheckResponse_SERVING)
+		select {
+		case <-srv.Context().Done():
+			return srv.Context().Err()
+		case event, ok := <-events:
+			if !ok {
+				return status.Errorf(codes.ResourceExhausted, "event watcher of volume %v fell behind", cs.c.VolumeName)
 			}
-		} else {
-			if err := ws.Send(&healthpb.HealthCheckResponse{
-				Status: healthpb.HealthCheckResponse_NOT_SERVING,
-			}); err != nil {
-				logrus.WithError(err).Errorf("Failed to send health check result %v for gRPC controller server",
-					healthpb.HealthCheckResponse_NOT_SERVING)
+			if err := srv.Send(event); err != nil {
+				return err
 			}
+		}
+	}
+}
 
+// servingStatus returns the status of service. The empty service is the
+// controller process itself, the named ones report the health of the volume.
+func (hc *ControllerHealthCheckServer) servingStatus(service string) healthpb.HealthCheckResponse_ServingStatus {
+	if hc.cs.c == nil {
+		return healthpb.HealthCheckResponse_NOT_SERVING
+	}
+
+	switch service {
+	case "":
+		return healthpb.HealthCheckResponse_SERVING
+	case types.HealthServiceVolume:
+		if hc.cs.c.Health() == types.VolumeHealthFaulted {
+			return healthpb.HealthCheckResponse_NOT_SERVING
 		}
-		time.Sleep(time.Second)
+		return healthpb.HealthCheckResponse_SERVING
+	case types.HealthServiceVolumeHealthy:
+		if hc.cs.c.Health() != types.VolumeHealthHealthy {
+			return healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		return healthpb.HealthCheckResponse_SERVING
+	default:
+		return healthpb.HealthCheckResponse_SERVICE_UNKNOWN
 	}
 }
+
+func (hc *ControllerHealthCheckServer) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	servingStatus := hc.servingStatus(req.Service)
+	if servingStatus == healthpb.HealthCheckResponse_SERVICE_UNKNOWN {
+		return nil, status.Errorf(codes.NotFound, "unknown health service %v", req.Service)
+	}
+	return &healthpb.HealthCheckResponse{
+		Status: servingStatus,
+	}, nil
+}
+
+func (hc *ControllerHealthCheckServer) Watch(req *healthpb.HealthCheckRequest, ws healthpb.Health_WatchServer) error {
+	return ptypes.WatchHealth(ws, func() healthpb.HealthCheckResponse_ServingStatus {
+		return hc.servingStatus(req.Service)
+	})
+}
@@ -0,0 +1,171 @@
+package nbd
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/longhorn/longhorn-engine/pkg/types"
+)
+
+const (
+	frontendName = "nbd"
+
+	DefaultListenAddress = "localhost:10809"
+)
+
+type Device struct {
+	Name       string
+	Size       int64
+	SectorSize int64
+
+	sync.Mutex
+
+	listen   string
+	isUp     bool
+	listener net.Listener
+	conns    map[net.Conn]struct{}
+	backend  types.ReaderWriterUnmapperAt
+
+	// connWG tracks the connection goroutines, which in turn wait for their
+	// in-flight requests.
+	connWG sync.WaitGroup
+}
+
+func New(listen string) types.Frontend {
+	return &Device{
+		listen: listen,
+		conns:  map[net.Conn]struct{}{},
+	}
+}
+
+func (d *Device) FrontendName() string {
+	return frontendName
+}
+
+func (d *Device) Init(name string, size, sectorSize int64) error {
+	d.Name = name
+	d.Size = size
+	d.SectorSize = sectorSize
+	return d.Shutdown()
+}
+
+func (d *Device) Startup(rwu types.ReaderWriterUnmapperAt) error {
+	d.Lock()
+	defer d.Unlock()
+
+	ln, err := net.Listen("tcp", d.listen)
+	if err != nil {
+		return errors.Wrapf(err, "failed to listen on %v for NBD frontend", d.listen)
+	}
+	d.listener = ln
+	d.backend = rwu
+	d.isUp = true
+
+	logrus.Infof("NBD frontend of volume %v listening on %v", d.Name, ln.Addr())
+	go d.accept(ln)
+	return nil
+}
+
+// Shutdown closes the listener and all connections, then waits for the
+// requests in flight, so none of them reaches the backend once the frontend
+// is down.
+func (d *Device) Shutdown() error {
+	d.Lock()
+	if d.listener != nil {
+		logrus.Infof("Shutting down NBD frontend for %v", d.Name)
+		if err := d.listener.Close(); err != nil {
+			logrus.WithError(err).Warnf("Failed to close NBD listener of volume %v", d.Name)
+		}
+		d.listener = nil
+	}
+	for conn := range d.conns {
+		conn.Close()
+	}
+	d.isUp = false
+	d.Unlock()
+
+	d.connWG.Wait()
+	return nil
+}
+
+func (d *Device) accept(ln net.Listener) {
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			logrus.WithError(err).Error("Failed to accept NBD connection")
+			continue
+		}
+
+		d.Lock()
+		if !d.isUp {
+			d.Unlock()
+			conn.Close()
+			continue
+		}
+		d.conns[conn] = struct{}{}
+		c := newConnection(conn, d.Name, d.Size, d.SectorSize, d.backend)
+		d.connWG.Add(1)
+		d.Unlock()
+
+		go d.handleConnection(conn, c)
+	}
+}
+
+func (d *Device) handleConnection(conn net.Conn, c *connection) {
+	defer func() {
+		d.Lock()
+		delete(d.conns, conn)
+		d.Unlock()
+		conn.Close()
+		d.connWG.Done()
+	}()
+
+	logrus.Infof("New NBD connection from %v for volume %v", conn.RemoteAddr(), d.Name)
+	if err := c.serve(); err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, net.ErrClosed) {
+		logrus.WithError(err).Errorf("Failed to handle NBD connection from %v", conn.RemoteAddr())
+		return
+	}
+	logrus.Infof("NBD connection from %v closed", conn.RemoteAddr())
+}
+
+func (d *Device) State() types.State {
+	d.Lock()
+	defer d.Unlock()
+
+	if d.isUp {
+		return types.StateUp
+	}
+	return types.StateDown
+}
+
+func (d *Device) Endpoint() string {
+	d.Lock()
+	defer d.Unlock()
+
+	if d.isUp {
+		return fmt.Sprintf("nbd://%s/%s", d.listener.Addr(), d.Name)
+	}
+	return ""
+}
+
+func (d *Device) Upgrade(name string, size, sectorSize int64, rwu types.ReaderWriterUnmapperAt) error {
+	return fmt.Errorf("upgrade is not supported")
+}
+
+// Expand only affects new connections, the NBD protocol has no way to notify
+// connected clients about a size change.
+func (d *Device) Expand(size int64) error {
+	d.Lock()
+	defer d.Unlock()
+
+	d.Size = size
+	return nil
+}
@@ -0,0 +1,108 @@
+package nbd
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// Wire constants of the NBD protocol, see
+// https://github.com/NetworkBlockDevice/nbd/blob/master/doc/proto.md
+const (
+	nbdMagic         = uint64(0x4e42444d41474943) // "NBDMAGIC"
+	nbdOptsMagic     = uint64(0x49484156454f5054) // "IHAVEOPT"
+	nbdRepMagic      = uint64(0x3e889045565a9)
+	nbdRequestMagic  = uint32(0x25609513)
+	nbdResponseMagic = uint32(0x67446698)
+
+	nbdFlagFixedNewstyle = uint16(1 << 0)
+	nbdFlagNoZeroes      = uint16(1 << 1)
+
+	nbdFlagCFixedNewstyle = uint32(1 << 0)
+	nbdFlagCNoZeroes      = uint32(1 << 1)
+
+	nbdFlagHasFlags     = uint16(1 << 0)
+	nbdFlagSendTrim     = uint16(1 << 5)
+	nbdFlagCanMultiConn = uint16(1 << 8)
+
+	nbdOptExportName = uint32(1)
+	nbdOptAbort      = uint32(2)
+	nbdOptList       = uint32(3)
+	nbdOptInfo       = uint32(6)
+	nbdOptGo         = uint32(7)
+
+	nbdRepAck        = uint32(1)
+	nbdRepServer     = uint32(2)
+	nbdRepInfo       = uint32(3)
+	nbdRepFlagError  = uint32(1 << 31)
+	nbdRepErrUnsup   = nbdRepFlagError | 1
+	nbdRepErrInvalid = nbdRepFlagError | 3
+	nbdRepErrUnknown = nbdRepFlagError | 6
+
+	nbdInfoExport    = uint16(0)
+	nbdInfoBlockSize = uint16(3)
+
+	nbdCmdRead  = uint16(0)
+	nbdCmdWrite = uint16(1)
+	nbdCmdDisc  = uint16(2)
+	nbdCmdTrim  = uint16(4)
+
+	nbdEIO     = uint32(5)
+	nbdEINVAL  = uint32(22)
+	nbdENOTSUP = uint32(95)
+
+	// maxOptionLength bounds the payload a client may send with a single
+	// handshake option.
+	maxOptionLength = 4096
+	// maxRequestLength bounds the buffer allocated for a single read or
+	// write request. The Linux NBD client never exceeds this.
+	maxRequestLength = 32 << 20
+)
+
+type request struct {
+	Magic  uint32
+	Flags  uint16
+	Type   uint16
+	Handle uint64
+	Offset uint64
+	Length uint32
+}
+
+type simpleReply struct {
+	Magic  uint32
+	Error  uint32
+	Handle uint64
+}
+
+type optionHeader struct {
+	Magic  uint64
+	Option uint32
+	Length uint32
+}
+
+type optionReplyHeader struct {
+	Magic  uint64
+	Option uint32
+	Type   uint32
+	Length uint32
+}
+
+func writeOptionReply(w io.Writer, option, replyType uint32, data []byte) error {
+	header := optionReplyHeader{
+		Magic:  nbdRepMagic,
+		Option: option,
+		Type:   replyType,
+		Length: uint32(len(data)),
+	}
+	if err := binary.Write(w, binary.BigEndian, header); err != nil {
+		return errors.Wrap(err, "failed to write option reply header")
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	if _, err := w.Write(data); err != nil {
+		return errors.Wrap(err, "failed to write option reply data")
+	}
+	return nil
+}
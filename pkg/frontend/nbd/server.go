@@ -0,0 +1,323 @@
+package nbd
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/longhorn/longhorn-engine/pkg/types"
+)
+
+// connection serves a single NBD client. The handshake is done synchronously,
+// after that every request is handled in its own goroutine so the client can
+// keep multiple requests in flight.
+type connection struct {
+	conn       net.Conn
+	exportName string
+	size       int64
+	sectorSize int64
+	rwu        types.ReaderWriterUnmapperAt
+
+	noZeroes bool
+
+	writeLock sync.Mutex
+	wg        sync.WaitGroup
+}
+
+func newConnection(conn net.Conn, exportName string, size, sectorSize int64, rwu types.ReaderWriterUnmapperAt) *connection {
+	return &connection{
+		conn:       conn,
+		exportName: exportName,
+		size:       size,
+		sectorSize: sectorSize,
+		rwu:        rwu,
+	}
+}
+
+func (c *connection) serve() error {
+	ok, err := c.handshake()
+	if err != nil || !ok {
+		return err
+	}
+	return c.transmission()
+}
+
+func (c *connection) transmissionFlags() uint16 {
+	return nbdFlagHasFlags | nbdFlagSendTrim | nbdFlagCanMultiConn
+}
+
+// handshake runs the fixed newstyle negotiation. It returns false without an
+// error if the client aborted the negotiation.
+func (c *connection) handshake() (bool, error) {
+	greeting := struct {
+		Magic     uint64
+		OptsMagic uint64
+		Flags     uint16
+	}{nbdMagic, nbdOptsMagic, nbdFlagFixedNewstyle | nbdFlagNoZeroes}
+	if err := binary.Write(c.conn, binary.BigEndian, greeting); err != nil {
+		return false, errors.Wrap(err, "failed to write handshake greeting")
+	}
+
+	var clientFlags uint32
+	if err := binary.Read(c.conn, binary.BigEndian, &clientFlags); err != nil {
+		return false, errors.Wrap(err, "failed to read client flags")
+	}
+	if clientFlags&nbdFlagCFixedNewstyle == 0 {
+		return false, errors.New("client does not support fixed newstyle negotiation")
+	}
+	c.noZeroes = clientFlags&nbdFlagCNoZeroes != 0
+
+	for {
+		var header optionHeader
+		if err := binary.Read(c.conn, binary.BigEndian, &header); err != nil {
+			return false, errors.Wrap(err, "failed to read option header")
+		}
+		if header.Magic != nbdOptsMagic {
+			return false, errors.Errorf("invalid option magic %x", header.Magic)
+		}
+		if header.Length > maxOptionLength {
+			return false, errors.Errorf("option %v length %v exceeds limit", header.Option, header.Length)
+		}
+		data := make([]byte, header.Length)
+		if _, err := io.ReadFull(c.conn, data); err != nil {
+			return false, errors.Wrap(err, "failed to read option data")
+		}
+
+		switch header.Option {
+		case nbdOptExportName:
+			if !c.isKnownExport(string(data)) {
+				return false, errors.Errorf("client requested unknown export %v", string(data))
+			}
+			return true, c.writeExportNameReply()
+		case nbdOptAbort:
+			return false, writeOptionReply(c.conn, header.Option, nbdRepAck, nil)
+		case nbdOptList:
+			if len(data) != 0 {
+				if err := writeOptionReply(c.conn, header.Option, nbdRepErrInvalid, nil); err != nil {
+					return false, err
+				}
+				continue
+			}
+			if err := c.writeListReply(header.Option); err != nil {
+				return false, err
+			}
+		case nbdOptInfo, nbdOptGo:
+			done, err := c.handleInfoOption(header.Option, data)
+			if err != nil || done {
+				return done, err
+			}
+		default:
+			if err := writeOptionReply(c.conn, header.Option, nbdRepErrUnsup, nil); err != nil {
+				return false, err
+			}
+		}
+	}
+}
+
+// isKnownExport accepts the volume name and the empty default export name.
+func (c *connection) isKnownExport(name string) bool {
+	return name == "" || name == c.exportName
+}
+
+func (c *connection) writeExportNameReply() error {
+	reply := struct {
+		Size  uint64
+		Flags uint16
+	}{uint64(c.size), c.transmissionFlags()}
+	if err := binary.Write(c.conn, binary.BigEndian, reply); err != nil {
+		return errors.Wrap(err, "failed to write export name reply")
+	}
+	if !c.noZeroes {
+		if _, err := c.conn.Write(make([]byte, 124)); err != nil {
+			return errors.Wrap(err, "failed to write export name reply padding")
+		}
+	}
+	return nil
+}
+
+func (c *connection) writeListReply(option uint32) error {
+	buf := &bytes.Buffer{}
+	binary.Write(buf, binary.BigEndian, uint32(len(c.exportName)))
+	buf.WriteString(c.exportName)
+	if err := writeOptionReply(c.conn, option, nbdRepServer, buf.Bytes()); err != nil {
+		return err
+	}
+	return writeOptionReply(c.conn, option, nbdRepAck, nil)
+}
+
+// handleInfoOption answers NBD_OPT_INFO and NBD_OPT_GO. It returns true if
+// the negotiation finished and the transmission phase should start.
+func (c *connection) handleInfoOption(option uint32, data []byte) (bool, error) {
+	r := bytes.NewReader(data)
+	var nameLength uint32
+	if err := binary.Read(r, binary.BigEndian, &nameLength); err != nil || int(nameLength) > r.Len() {
+		return false, writeOptionReply(c.conn, option, nbdRepErrInvalid, nil)
+	}
+	name := make([]byte, nameLength)
+	if _, err := io.ReadFull(r, name); err != nil {
+		return false, writeOptionReply(c.conn, option, nbdRepErrInvalid, nil)
+	}
+	if !c.isKnownExport(string(name)) {
+		return false, writeOptionReply(c.conn, option, nbdRepErrUnknown, nil)
+	}
+
+	info := &bytes.Buffer{}
+	binary.Write(info, binary.BigEndian, nbdInfoExport)
+	binary.Write(info, binary.BigEndian, uint64(c.size))
+	binary.Write(info, binary.BigEndian, c.transmissionFlags())
+	if err := writeOptionReply(c.conn, option, nbdRepInfo, info.Bytes()); err != nil {
+		return false, err
+	}
+
+	info.Reset()
+	binary.Write(info, binary.BigEndian, nbdInfoBlockSize)
+	binary.Write(info, binary.BigEndian, uint32(1))
+	binary.Write(info, binary.BigEndian, uint32(c.sectorSize))
+	binary.Write(info, binary.BigEndian, uint32(maxRequestLength))
+	if err := writeOptionReply(c.conn, option, nbdRepInfo, info.Bytes()); err != nil {
+		return false, err
+	}
+
+	if err := writeOptionReply(c.conn, option, nbdRepAck, nil); err != nil {
+		return false, err
+	}
+	return option == nbdOptGo, nil
+}
+
+func (c *connection) transmission() error {
+	defer c.wg.Wait()
+
+	for {
+		var req request
+		if err := binary.Read(c.conn, binary.BigEndian, &req); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return errors.Wrap(err, "failed to read request")
+		}
+		if req.Magic != nbdRequestMagic {
+			return errors.Errorf("invalid request magic %x", req.Magic)
+		}
+
+		switch req.Type {
+		case nbdCmdDisc:
+			return nil
+		case nbdCmdRead:
+			if req.Length > maxRequestLength || !c.validRange(req) {
+				if err := c.reply(req.Handle, nbdEINVAL, nil); err != nil {
+					return err
+				}
+				continue
+			}
+			c.wg.Add(1)
+			go c.handleRead(req)
+		case nbdCmdWrite:
+			if req.Length > maxRequestLength {
+				return errors.Errorf("write request length %v exceeds limit", req.Length)
+			}
+			buf := make([]byte, req.Length)
+			if _, err := io.ReadFull(c.conn, buf); err != nil {
+				return errors.Wrap(err, "failed to read write request data")
+			}
+			if !c.validRange(req) {
+				if err := c.reply(req.Handle, nbdEINVAL, nil); err != nil {
+					return err
+				}
+				continue
+			}
+			c.wg.Add(1)
+			go c.handleWrite(req, buf)
+		case nbdCmdTrim:
+			if !c.validRange(req) {
+				if err := c.reply(req.Handle, nbdEINVAL, nil); err != nil {
+					return err
+				}
+				continue
+			}
+			c.wg.Add(1)
+			go c.handleTrim(req)
+		default:
+			if err := c.reply(req.Handle, nbdENOTSUP, nil); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// validRange checks the request against the export size without computing
+// Offset+Length, which wraps for offsets close to 2^64. The maxRequestLength
+// cap is left to the callers, since it only applies to READ and WRITE: TRIM
+// carries no payload and the kernel sends discards of up to 4 GiB.
+func (c *connection) validRange(req request) bool {
+	return req.Offset <= uint64(c.size) && uint64(req.Length) <= uint64(c.size)-req.Offset
+}
+
+func (c *connection) handleRead(req request) {
+	defer c.wg.Done()
+
+	buf := make([]byte, req.Length)
+	if _, err := c.rwu.ReadAt(buf, int64(req.Offset)); err != nil {
+		logrus.WithError(err).Errorf("Failed to read %v bytes at offset %v for NBD client", req.Length, req.Offset)
+		c.replyAsync(req.Handle, nbdEIO, nil)
+		return
+	}
+	c.replyAsync(req.Handle, 0, buf)
+}
+
+func (c *connection) handleWrite(req request, buf []byte) {
+	defer c.wg.Done()
+
+	if _, err := c.rwu.WriteAt(buf, int64(req.Offset)); err != nil {
+		logrus.WithError(err).Errorf("Failed to write %v bytes at offset %v for NBD client", req.Length, req.Offset)
+		c.replyAsync(req.Handle, nbdEIO, nil)
+		return
+	}
+	c.replyAsync(req.Handle, 0, nil)
+}
+
+func (c *connection) handleTrim(req request) {
+	defer c.wg.Done()
+
+	if _, err := c.rwu.UnmapAt(req.Length, int64(req.Offset)); err != nil {
+		logrus.WithError(err).Errorf("Failed to unmap %v bytes at offset %v for NBD client", req.Length, req.Offset)
+		c.replyAsync(req.Handle, nbdEIO, nil)
+		return
+	}
+	c.replyAsync(req.Handle, 0, nil)
+}
+
+// replyAsync is used by the request goroutines. A failed reply means the
+// connection is broken, so the error is only logged and the main loop will
+// notice the closed connection on its next read.
+func (c *connection) replyAsync(handle uint64, errno uint32, data []byte) {
+	if err := c.reply(handle, errno, data); err != nil {
+		logrus.WithError(err).Error("Failed to reply to NBD client")
+		c.conn.Close()
+	}
+}
+
+func (c *connection) reply(handle uint64, errno uint32, data []byte) error {
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+
+	header := simpleReply{
+		Magic:  nbdResponseMagic,
+		Error:  errno,
+		Handle: handle,
+	}
+	if err := binary.Write(c.conn, binary.BigEndian, header); err != nil {
+		return errors.Wrap(err, "failed to write reply header")
+	}
+	if errno != 0 || len(data) == 0 {
+		return nil
+	}
+	if _, err := c.conn.Write(data); err != nil {
+		return errors.Wrap(err, "failed to write reply data")
+	}
+	return nil
+}
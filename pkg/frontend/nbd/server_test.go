@@ -0,0 +1,365 @@
+package nbd
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	. "gopkg.in/check.v1"
+
+	"github.com/longhorn/longhorn-engine/pkg/types"
+)
+
+const (
+	testVolumeName = "test-volume"
+	testSize       = 1 << 20
+	testSectorSize = 512
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type TestSuite struct {
+}
+
+var _ = Suite(&TestSuite{})
+
+type memBackend struct {
+	sync.Mutex
+
+	data     []byte
+	unmapped [][2]int64
+
+	// If set, WriteAt signals writeStarted and then waits for releaseWrite
+	writeStarted chan struct{}
+	releaseWrite chan struct{}
+}
+
+func newMemBackend() *memBackend {
+	return &memBackend{data: make([]byte, testSize)}
+}
+
+func (b *memBackend) ReadAt(buf []byte, off int64) (int, error) {
+	b.Lock()
+	defer b.Unlock()
+	return copy(buf, b.data[off:]), nil
+}
+
+func (b *memBackend) WriteAt(buf []byte, off int64) (int, error) {
+	if b.writeStarted != nil {
+		b.writeStarted <- struct{}{}
+		<-b.releaseWrite
+	}
+	b.Lock()
+	defer b.Unlock()
+	return copy(b.data[off:], buf), nil
+}
+
+func (b *memBackend) UnmapAt(length uint32, off int64) (int, error) {
+	b.Lock()
+	defer b.Unlock()
+	b.unmapped = append(b.unmapped, [2]int64{off, int64(length)})
+	return int(length), nil
+}
+
+// testClient speaks the client side of the protocol.
+type testClient struct {
+	c    *C
+	conn net.Conn
+}
+
+func (t *testClient) handshake(clientFlags uint32) {
+	var greeting struct {
+		Magic     uint64
+		OptsMagic uint64
+		Flags     uint16
+	}
+	t.c.Assert(binary.Read(t.conn, binary.BigEndian, &greeting), IsNil)
+	t.c.Assert(greeting.Magic, Equals, nbdMagic)
+	t.c.Assert(greeting.OptsMagic, Equals, nbdOptsMagic)
+	t.c.Assert(greeting.Flags&nbdFlagFixedNewstyle, Not(Equals), uint16(0))
+	t.c.Assert(greeting.Flags&nbdFlagNoZeroes, Not(Equals), uint16(0))
+	t.c.Assert(binary.Write(t.conn, binary.BigEndian, clientFlags), IsNil)
+}
+
+func (t *testClient) sendOption(option uint32, data []byte) {
+	header := optionHeader{Magic: nbdOptsMagic, Option: option, Length: uint32(len(data))}
+	t.c.Assert(binary.Write(t.conn, binary.BigEndian, header), IsNil)
+	// An empty write on a net.Pipe blocks until the other side reads
+	if len(data) > 0 {
+		_, err := t.conn.Write(data)
+		t.c.Assert(err, IsNil)
+	}
+}
+
+func (t *testClient) readOptionReply(option uint32) (uint32, []byte) {
+	var header optionReplyHeader
+	t.c.Assert(binary.Read(t.conn, binary.BigEndian, &header), IsNil)
+	t.c.Assert(header.Magic, Equals, nbdRepMagic)
+	t.c.Assert(header.Option, Equals, option)
+	data := make([]byte, header.Length)
+	_, err := io.ReadFull(t.conn, data)
+	t.c.Assert(err, IsNil)
+	return header.Type, data
+}
+
+func infoRequest(name string) []byte {
+	buf := &bytes.Buffer{}
+	binary.Write(buf, binary.BigEndian, uint32(len(name)))
+	buf.WriteString(name)
+	binary.Write(buf, binary.BigEndian, uint16(0))
+	return buf.Bytes()
+}
+
+// negotiate runs the handshake up to a successful NBD_OPT_GO.
+func (t *testClient) negotiate() {
+	t.handshake(nbdFlagCFixedNewstyle | nbdFlagCNoZeroes)
+	t.sendOption(nbdOptGo, infoRequest(testVolumeName))
+	for {
+		replyType, _ := t.readOptionReply(nbdOptGo)
+		if replyType == nbdRepAck {
+			return
+		}
+		t.c.Assert(replyType, Equals, nbdRepInfo)
+	}
+}
+
+func (t *testClient) sendRequest(cmd uint16, handle, offset uint64, length uint32, data []byte) {
+	req := request{Magic: nbdRequestMagic, Type: cmd, Handle: handle, Offset: offset, Length: length}
+	t.c.Assert(binary.Write(t.conn, binary.BigEndian, req), IsNil)
+	if len(data) > 0 {
+		_, err := t.conn.Write(data)
+		t.c.Assert(err, IsNil)
+	}
+}
+
+func (t *testClient) readReply(handle uint64, dataLength int) (uint32, []byte) {
+	var reply simpleReply
+	t.c.Assert(binary.Read(t.conn, binary.BigEndian, &reply), IsNil)
+	t.c.Assert(reply.Magic, Equals, nbdResponseMagic)
+	t.c.Assert(reply.Handle, Equals, handle)
+	if reply.Error != 0 {
+		return reply.Error, nil
+	}
+	data := make([]byte, dataLength)
+	_, err := io.ReadFull(t.conn, data)
+	t.c.Assert(err, IsNil)
+	return 0, data
+}
+
+func startTestConnection(c *C, backend *memBackend, size int64) (*testClient, chan error) {
+	serverConn, clientConn := net.Pipe()
+	conn := newConnection(serverConn, testVolumeName, size, testSectorSize, backend)
+	done := make(chan error, 1)
+	go func() {
+		done <- conn.serve()
+		serverConn.Close()
+	}()
+	return &testClient{c: c, conn: clientConn}, done
+}
+
+func (s *TestSuite) TestHandshake(c *C) {
+	client, done := startTestConnection(c, newMemBackend(), testSize)
+	defer client.conn.Close()
+	client.handshake(nbdFlagCFixedNewstyle | nbdFlagCNoZeroes)
+
+	// NBD_OPT_LIST returns the volume
+	client.sendOption(nbdOptList, nil)
+	replyType, data := client.readOptionReply(nbdOptList)
+	c.Assert(replyType, Equals, nbdRepServer)
+	c.Assert(binary.BigEndian.Uint32(data), Equals, uint32(len(testVolumeName)))
+	c.Assert(string(data[4:]), Equals, testVolumeName)
+	replyType, _ = client.readOptionReply(nbdOptList)
+	c.Assert(replyType, Equals, nbdRepAck)
+
+	// An unknown export is refused and the negotiation goes on
+	client.sendOption(nbdOptGo, infoRequest("other-volume"))
+	replyType, _ = client.readOptionReply(nbdOptGo)
+	c.Assert(replyType, Equals, nbdRepErrUnknown)
+
+	// Unsupported options are refused as well
+	client.sendOption(42, nil)
+	replyType, _ = client.readOptionReply(42)
+	c.Assert(replyType, Equals, nbdRepErrUnsup)
+
+	// NBD_OPT_GO reports the export and block sizes, then starts transmission
+	client.sendOption(nbdOptGo, infoRequest(testVolumeName))
+	replyType, data = client.readOptionReply(nbdOptGo)
+	c.Assert(replyType, Equals, nbdRepInfo)
+	c.Assert(binary.BigEndian.Uint16(data[0:2]), Equals, nbdInfoExport)
+	c.Assert(binary.BigEndian.Uint64(data[2:10]), Equals, uint64(testSize))
+	flags := binary.BigEndian.Uint16(data[10:12])
+	c.Assert(flags&nbdFlagSendTrim, Not(Equals), uint16(0))
+	replyType, data = client.readOptionReply(nbdOptGo)
+	c.Assert(replyType, Equals, nbdRepInfo)
+	c.Assert(binary.BigEndian.Uint16(data[0:2]), Equals, nbdInfoBlockSize)
+	c.Assert(binary.BigEndian.Uint32(data[6:10]), Equals, uint32(testSectorSize))
+	replyType, _ = client.readOptionReply(nbdOptGo)
+	c.Assert(replyType, Equals, nbdRepAck)
+
+	client.sendRequest(nbdCmdDisc, 1, 0, 0, nil)
+	c.Assert(<-done, IsNil)
+}
+
+func (s *TestSuite) TestHandshakeExportName(c *C) {
+	client, done := startTestConnection(c, newMemBackend(), testSize)
+	defer client.conn.Close()
+	// Without NO_ZEROES, the reply is padded with 124 bytes
+	client.handshake(nbdFlagCFixedNewstyle)
+
+	client.sendOption(nbdOptExportName, []byte(testVolumeName))
+	var reply struct {
+		Size  uint64
+		Flags uint16
+	}
+	c.Assert(binary.Read(client.conn, binary.BigEndian, &reply), IsNil)
+	c.Assert(reply.Size, Equals, uint64(testSize))
+	_, err := io.ReadFull(client.conn, make([]byte, 124))
+	c.Assert(err, IsNil)
+
+	client.sendRequest(nbdCmdDisc, 1, 0, 0, nil)
+	c.Assert(<-done, IsNil)
+}
+
+func (s *TestSuite) TestHandshakeRefused(c *C) {
+	// Oldstyle clients are not supported
+	client, done := startTestConnection(c, newMemBackend(), testSize)
+	client.handshake(0)
+	c.Assert(<-done, NotNil)
+	client.conn.Close()
+
+	// NBD_OPT_EXPORT_NAME has no way to report an error, the connection is closed
+	client, done = startTestConnection(c, newMemBackend(), testSize)
+	client.handshake(nbdFlagCFixedNewstyle | nbdFlagCNoZeroes)
+	client.sendOption(nbdOptExportName, []byte("other-volume"))
+	c.Assert(<-done, NotNil)
+	client.conn.Close()
+
+	// NBD_OPT_ABORT ends the negotiation without an error
+	client, done = startTestConnection(c, newMemBackend(), testSize)
+	client.handshake(nbdFlagCFixedNewstyle | nbdFlagCNoZeroes)
+	client.sendOption(nbdOptAbort, nil)
+	replyType, _ := client.readOptionReply(nbdOptAbort)
+	c.Assert(replyType, Equals, nbdRepAck)
+	c.Assert(<-done, IsNil)
+	client.conn.Close()
+}
+
+func (s *TestSuite) TestTransmission(c *C) {
+	backend := newMemBackend()
+	client, done := startTestConnection(c, backend, testSize)
+	defer client.conn.Close()
+	client.negotiate()
+
+	data := bytes.Repeat([]byte{0xab}, 4096)
+	client.sendRequest(nbdCmdWrite, 1, 8192, uint32(len(data)), data)
+	errno, _ := client.readReply(1, 0)
+	c.Assert(errno, Equals, uint32(0))
+	c.Assert(backend.data[8192:8192+4096], DeepEquals, data)
+
+	client.sendRequest(nbdCmdRead, 2, 8192-512, 1024, nil)
+	errno, read := client.readReply(2, 1024)
+	c.Assert(errno, Equals, uint32(0))
+	c.Assert(read[:512], DeepEquals, make([]byte, 512))
+	c.Assert(read[512:], DeepEquals, data[:512])
+
+	client.sendRequest(nbdCmdTrim, 3, 8192, 4096, nil)
+	errno, _ = client.readReply(3, 0)
+	c.Assert(errno, Equals, uint32(0))
+	c.Assert(backend.unmapped, DeepEquals, [][2]int64{{8192, 4096}})
+
+	// The last sector can be read, anything beyond the export is EINVAL
+	client.sendRequest(nbdCmdRead, 4, testSize-512, 512, nil)
+	errno, _ = client.readReply(4, 512)
+	c.Assert(errno, Equals, uint32(0))
+	client.sendRequest(nbdCmdRead, 5, testSize-512, 1024, nil)
+	errno, _ = client.readReply(5, 0)
+	c.Assert(errno, Equals, nbdEINVAL)
+	client.sendRequest(nbdCmdTrim, 6, testSize, 512, nil)
+	errno, _ = client.readReply(6, 0)
+	c.Assert(errno, Equals, nbdEINVAL)
+
+	// Offset+Length wraps around, it must not pass the check
+	client.sendRequest(nbdCmdWrite, 7, ^uint64(0)-255, 512, make([]byte, 512))
+	errno, _ = client.readReply(7, 0)
+	c.Assert(errno, Equals, nbdEINVAL)
+
+	client.sendRequest(42, 8, 0, 0, nil)
+	errno, _ = client.readReply(8, 0)
+	c.Assert(errno, Equals, nbdENOTSUP)
+
+	client.sendRequest(nbdCmdDisc, 9, 0, 0, nil)
+	c.Assert(<-done, IsNil)
+}
+
+func (s *TestSuite) TestLargeTrim(c *C) {
+	// The export is only backed for its first testSize bytes, which is
+	// enough since TRIM does not touch the data
+	size := int64(4 * maxRequestLength)
+	backend := newMemBackend()
+	client, done := startTestConnection(c, backend, size)
+	defer client.conn.Close()
+	client.negotiate()
+
+	// TRIM is not bound by the payload limit, only by the export size
+	client.sendRequest(nbdCmdTrim, 1, 0, 2*maxRequestLength, nil)
+	errno, _ := client.readReply(1, 0)
+	c.Assert(errno, Equals, uint32(0))
+	c.Assert(backend.unmapped, DeepEquals, [][2]int64{{0, 2 * maxRequestLength}})
+	client.sendRequest(nbdCmdTrim, 2, uint64(size)-maxRequestLength, 2*maxRequestLength, nil)
+	errno, _ = client.readReply(2, 0)
+	c.Assert(errno, Equals, nbdEINVAL)
+
+	// READ still is
+	client.sendRequest(nbdCmdRead, 3, 0, maxRequestLength+512, nil)
+	errno, _ = client.readReply(3, 0)
+	c.Assert(errno, Equals, nbdEINVAL)
+
+	client.sendRequest(nbdCmdDisc, 4, 0, 0, nil)
+	c.Assert(<-done, IsNil)
+}
+
+func (s *TestSuite) TestShutdownWaitsForRequests(c *C) {
+	backend := newMemBackend()
+	backend.writeStarted = make(chan struct{})
+	backend.releaseWrite = make(chan struct{})
+
+	d := New("127.0.0.1:0").(*Device)
+	c.Assert(d.Init(testVolumeName, testSize, testSectorSize), IsNil)
+	c.Assert(d.Startup(backend), IsNil)
+
+	d.Lock()
+	address := d.listener.Addr().String()
+	d.Unlock()
+	conn, err := net.Dial("tcp", address)
+	c.Assert(err, IsNil)
+	defer conn.Close()
+	client := &testClient{c: c, conn: conn}
+	client.negotiate()
+
+	client.sendRequest(nbdCmdWrite, 1, 0, 512, make([]byte, 512))
+	<-backend.writeStarted
+
+	shutdownDone := make(chan struct{})
+	go func() {
+		c.Check(d.Shutdown(), IsNil)
+		close(shutdownDone)
+	}()
+
+	select {
+	case <-shutdownDone:
+		c.Fatal("Shutdown returned with a write in flight")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	close(backend.releaseWrite)
+	select {
+	case <-shutdownDone:
+	case <-time.After(5 * time.Second):
+		c.Fatal("Shutdown did not return after the write finished")
+	}
+	c.Assert(d.State(), Equals, types.StateDown)
+}
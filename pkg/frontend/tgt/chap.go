@@ -0,0 +1,308 @@
+package tgt
+
+import (
+	"bufio"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	lhexec "github.com/longhorn/go-common-libs/exec"
+	lhtypes "github.com/longhorn/go-common-libs/types"
+	"github.com/longhorn/go-iscsi-helper/iscsi"
+	"github.com/longhorn/go-iscsi-helper/iscsidev"
+	iscsitypes "github.com/longhorn/go-iscsi-helper/types"
+)
+
+const (
+	tgtBinary = "tgtadm"
+
+	// Common initiators only accept CHAP secrets of 12 to 16 characters.
+	minChapSecretLength = 12
+	maxChapSecretLength = 16
+)
+
+// ChapCredentials configures CHAP authentication of the iSCSI target.
+// Username/Password authenticate the initiator to the target (one-way CHAP).
+// If MutualUsername/MutualPassword are set as well, the target authenticates
+// itself to the initiator (mutual CHAP).
+//
+// tgtd accounts are global to the daemon, so volumes using the same username
+// share one account. tgtd cannot report the password of an account, so the
+// volumes sharing it must use the same password as well.
+type ChapCredentials struct {
+	Username       string
+	Password       string
+	MutualUsername string
+	MutualPassword string
+}
+
+func (c *ChapCredentials) Enabled() bool {
+	return c != nil && c.Username != ""
+}
+
+func (c *ChapCredentials) Validate() error {
+	if c == nil {
+		return nil
+	}
+	if c.Username == "" && c.Password != "" {
+		return errors.New("CHAP password is set without a username")
+	}
+	if c.Username == "" && (c.MutualUsername != "" || c.MutualPassword != "") {
+		return errors.New("mutual CHAP requires one-way CHAP credentials")
+	}
+	if c.Username != "" {
+		if err := validateChapSecret(c.Password); err != nil {
+			return errors.Wrap(err, "invalid CHAP password")
+		}
+	}
+	if c.MutualUsername != "" || c.MutualPassword != "" {
+		if c.MutualUsername == "" {
+			return errors.New("mutual CHAP password is set without a username")
+		}
+		if c.MutualUsername == c.Username {
+			return errors.New("mutual CHAP username must differ from the CHAP username")
+		}
+		if err := validateChapSecret(c.MutualPassword); err != nil {
+			return errors.Wrap(err, "invalid mutual CHAP password")
+		}
+	}
+	return nil
+}
+
+func validateChapSecret(secret string) error {
+	if len(secret) < minChapSecretLength || len(secret) > maxChapSecretLength {
+		return errors.Errorf("secret length must be between %v and %v characters", minChapSecretLength, maxChapSecretLength)
+	}
+	return nil
+}
+
+// createChapAccounts creates the tgtd accounts. It is called before the
+// target of the volume exists, so that once the device service has created
+// the target, which then accepts connections without authentication, only
+// bindChapAccounts is left to do. Accounts that exist already are shared.
+func createChapAccounts(chap *ChapCredentials) error {
+	if err := createOrShareAccount(chap.Username, chap.Password); err != nil {
+		return err
+	}
+	if chap.MutualUsername == "" {
+		return nil
+	}
+	return createOrShareAccount(chap.MutualUsername, chap.MutualPassword)
+}
+
+func createOrShareAccount(user, password string) error {
+	err := createAccount(user, password)
+	if err != nil && strings.Contains(err.Error(), iscsitypes.TgtadmUserExist) {
+		logrus.Infof("CHAP account %v exists already, sharing it with the other targets bound to it", user)
+		return nil
+	}
+	return err
+}
+
+// bindChapAccounts binds the tgtd accounts to the target of the volume. The
+// target accepted logins without authentication until then, so any session
+// opened in the meantime is dropped. Initiators log in again, this time
+// through CHAP.
+func bindChapAccounts(volumeName string, chap *ChapCredentials) error {
+	tid, err := getTargetTid(volumeName)
+	if err != nil {
+		return err
+	}
+	if tid == -1 {
+		return errors.Errorf("cannot find target of volume %v", volumeName)
+	}
+
+	if err := bindAccount(tid, chap.Username, false); err != nil {
+		return err
+	}
+	if chap.MutualUsername != "" {
+		if err := bindAccount(tid, chap.MutualUsername, true); err != nil {
+			return err
+		}
+	}
+	return closeTargetConnections(tid)
+}
+
+func closeTargetConnections(tid int) error {
+	connections, err := iscsi.GetTargetConnections(tid)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get connections of target %v", tid)
+	}
+	for sid, cids := range connections {
+		for _, cid := range cids {
+			logrus.Warnf("Closing connection %v of session %v, opened on target %v before CHAP was enabled", cid, sid, tid)
+			if err := iscsi.CloseConnection(tid, sid, cid); err != nil {
+				return errors.Wrapf(err, "failed to close connection %v of session %v on target %v", cid, sid, tid)
+			}
+		}
+	}
+	return nil
+}
+
+// cleanupChap unbinds the tgtd accounts from the target of the volume, if it
+// still exists, and deletes the accounts no other target is bound to.
+// Deleting an account unbinds it from every target, which would leave the
+// other volumes sharing it without authentication. Missing accounts are
+// ignored.
+func cleanupChap(volumeName string, chap *ChapCredentials) error {
+	tid, err := getTargetTid(volumeName)
+	if err != nil {
+		return err
+	}
+	bindings, err := getAccountBindings()
+	if err != nil {
+		return err
+	}
+
+	for _, account := range []struct {
+		user     string
+		outgoing bool
+	}{
+		{chap.Username, false},
+		{chap.MutualUsername, true},
+	} {
+		if account.user == "" {
+			continue
+		}
+
+		otherTids := []int{}
+		for _, boundTid := range bindings[account.user] {
+			if boundTid != tid {
+				otherTids = append(otherTids, boundTid)
+				continue
+			}
+			if err := unbindAccount(tid, account.user, account.outgoing); err != nil {
+				return err
+			}
+		}
+		if len(otherTids) != 0 {
+			logrus.Infof("Keeping CHAP account %v, it is still bound to targets %v", account.user, otherTids)
+			continue
+		}
+
+		if err := deleteAccount(account.user); err != nil {
+			if !strings.Contains(err.Error(), iscsitypes.TgtadmNoUser) {
+				return err
+			}
+			logrus.WithError(err).Debugf("CHAP account %v does not exist", account.user)
+		}
+	}
+	return nil
+}
+
+func getTargetTid(volumeName string) (int, error) {
+	tid, err := iscsi.GetTargetTid(iscsidev.GetTargetName(volumeName))
+	if err != nil {
+		return -1, errors.Wrapf(err, "failed to get target ID of volume %v", volumeName)
+	}
+	return tid, nil
+}
+
+// getAccountBindings returns the IDs of the targets each tgtd account is bound
+// to.
+func getAccountBindings() (map[string][]int, error) {
+	opts := []string{
+		"--lld", "iscsi",
+		"--op", "show",
+		"--mode", "target",
+	}
+	output, err := lhexec.NewExecutor().Execute(nil, tgtBinary, opts, lhtypes.ExecuteDefaultTimeout)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list targets")
+	}
+	return parseAccountBindings(output)
+}
+
+func parseAccountBindings(output string) (map[string][]int, error) {
+	/* Output will look like:
+	Target 1: iqn.2019-10.io.longhorn:vol-a
+	    System information:
+	        ...
+	    Account information:
+	        user-a
+	        mutual-user-a (outgoing)
+	    ACL information:
+	        ALL
+	*/
+	bindings := map[string][]int{}
+	tid := -1
+	inAccounts := false
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "Target "):
+			tidString := strings.TrimPrefix(strings.SplitN(line, ":", 2)[0], "Target ")
+			var err error
+			if tid, err = strconv.Atoi(tidString); err != nil {
+				return nil, errors.Wrapf(err, "failed to parse target ID from line %v", line)
+			}
+			inAccounts = false
+		case !strings.HasPrefix(line, "        "):
+			// A section of the target
+			inAccounts = strings.TrimSpace(line) == "Account information:"
+		case inAccounts:
+			user := strings.TrimSuffix(strings.TrimSpace(line), " (outgoing)")
+			bindings[user] = append(bindings[user], tid)
+		}
+	}
+	return bindings, scanner.Err()
+}
+
+func createAccount(user, password string) error {
+	opts := []string{
+		"--lld", "iscsi",
+		"--op", "new",
+		"--mode", "account",
+		"--user", user,
+		"--password", password,
+	}
+	if _, err := lhexec.NewExecutor().Execute(nil, tgtBinary, opts, lhtypes.ExecuteDefaultTimeout); err != nil {
+		// The executor error contains the command line, keep the secret out of the logs
+		return errors.Errorf("failed to create CHAP account %v: %v", user, strings.ReplaceAll(err.Error(), password, "<redacted>"))
+	}
+	return nil
+}
+
+func bindAccount(tid int, user string, outgoing bool) error {
+	opts := []string{
+		"--lld", "iscsi",
+		"--op", "bind",
+		"--mode", "account",
+		"--tid", strconv.Itoa(tid),
+		"--user", user,
+	}
+	if outgoing {
+		opts = append(opts, "--outgoing")
+	}
+	_, err := lhexec.NewExecutor().Execute(nil, tgtBinary, opts, lhtypes.ExecuteDefaultTimeout)
+	return errors.Wrapf(err, "failed to bind CHAP account %v to target %v", user, tid)
+}
+
+func unbindAccount(tid int, user string, outgoing bool) error {
+	opts := []string{
+		"--lld", "iscsi",
+		"--op", "unbind",
+		"--mode", "account",
+		"--tid", strconv.Itoa(tid),
+		"--user", user,
+	}
+	if outgoing {
+		opts = append(opts, "--outgoing")
+	}
+	_, err := lhexec.NewExecutor().Execute(nil, tgtBinary, opts, lhtypes.ExecuteDefaultTimeout)
+	return errors.Wrapf(err, "failed to unbind CHAP account %v from target %v", user, tid)
+}
+
+func deleteAccount(user string) error {
+	opts := []string{
+		"--lld", "iscsi",
+		"--op", "delete",
+		"--mode", "account",
+		"--user", user,
+	}
+	_, err := lhexec.NewExecutor().Execute(nil, tgtBinary, opts, lhtypes.ExecuteDefaultTimeout)
+	return err
+}
@@ -0,0 +1,100 @@
+package tgt
+
+import (
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type TestSuite struct {
+}
+
+var _ = Suite(&TestSuite{})
+
+func (s *TestSuite) TestChapCredentialsValidate(c *C) {
+	const (
+		secret       = "0123456789ab"
+		mutualSecret = "ba9876543210"
+	)
+	testCases := []struct {
+		name    string
+		chap    *ChapCredentials
+		enabled bool
+		valid   bool
+	}{
+		{"nil", nil, false, true},
+		{"empty", &ChapCredentials{}, false, true},
+		{"one-way", &ChapCredentials{Username: "user", Password: secret}, true, true},
+		{"one-way longest secret", &ChapCredentials{Username: "user", Password: "0123456789abcdef"}, true, true},
+		{"mutual", &ChapCredentials{Username: "user", Password: secret, MutualUsername: "target", MutualPassword: mutualSecret}, true, true},
+		{"password without username", &ChapCredentials{Password: secret}, false, false},
+		{"mutual without one-way", &ChapCredentials{MutualUsername: "target", MutualPassword: mutualSecret}, false, false},
+		{"missing password", &ChapCredentials{Username: "user"}, true, false},
+		{"short secret", &ChapCredentials{Username: "user", Password: "0123456789a"}, true, false},
+		{"long secret", &ChapCredentials{Username: "user", Password: "0123456789abcdefg"}, true, false},
+		{"mutual password without username", &ChapCredentials{Username: "user", Password: secret, MutualPassword: mutualSecret}, true, false},
+		{"mutual username without password", &ChapCredentials{Username: "user", Password: secret, MutualUsername: "target"}, true, false},
+		{"same mutual username", &ChapCredentials{Username: "user", Password: secret, MutualUsername: "user", MutualPassword: mutualSecret}, true, false},
+		{"short mutual secret", &ChapCredentials{Username: "user", Password: secret, MutualUsername: "target", MutualPassword: "short"}, true, false},
+	}
+	for _, tc := range testCases {
+		c.Assert(tc.chap.Enabled(), Equals, tc.enabled, Commentf(tc.name))
+		err := tc.chap.Validate()
+		if tc.valid {
+			c.Assert(err, IsNil, Commentf(tc.name))
+		} else {
+			c.Assert(err, NotNil, Commentf(tc.name))
+		}
+	}
+}
+
+func (s *TestSuite) TestParseAccountBindings(c *C) {
+	output := `Target 1: iqn.2019-10.io.longhorn:vol-a
+    System information:
+        Driver: iscsi
+        State: ready
+    I_T nexus information:
+        I_T nexus: 1
+            Initiator: iqn.1993-08.org.debian:01:host alias: host
+            Connection: 0
+                IP Address: 10.0.0.1
+    LUN information:
+        LUN: 0
+            Type: controller
+    Account information:
+        shared-user
+        mutual-user-a (outgoing)
+    ACL information:
+        ALL
+Target 2: iqn.2019-10.io.longhorn:vol-b
+    System information:
+        Driver: iscsi
+        State: ready
+    Account information:
+        shared-user
+    ACL information:
+        ALL
+Target 3: iqn.2019-10.io.longhorn:vol-c
+    System information:
+        Driver: iscsi
+        State: ready
+    Account information:
+    ACL information:
+        ALL
+`
+	bindings, err := parseAccountBindings(output)
+	c.Assert(err, IsNil)
+	c.Assert(bindings, DeepEquals, map[string][]int{
+		"shared-user":   {1, 2},
+		"mutual-user-a": {1},
+	})
+
+	bindings, err = parseAccountBindings("")
+	c.Assert(err, IsNil)
+	c.Assert(bindings, HasLen, 0)
+
+	_, err = parseAccountBindings("Target x: iqn.2019-10.io.longhorn:vol-a\n")
+	c.Assert(err, NotNil)
+}
@@ -1,11 +1,16 @@
 package tgt
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 
+	"github.com/longhorn/go-iscsi-helper/iscsi"
+	"github.com/longhorn/go-iscsi-helper/iscsidev"
 	"github.com/longhorn/go-iscsi-helper/longhorndev"
+	devtypes "github.com/longhorn/go-iscsi-helper/types"
 	"github.com/longhorn/longhorn-engine/pkg/frontend/socket"
 	"github.com/longhorn/longhorn-engine/pkg/types"
 )
@@ -14,6 +19,11 @@ const (
 	DevPath = "/dev/longhorn/"
 
 	DefaultTargetID = 1
+
+	// lbppbe512e is the logical blocks per physical block exponent reported
+	// by READ CAPACITY(16) for 512-byte logical blocks on 4096-byte physical
+	// blocks.
+	lbppbe512e = "3"
 )
 
 type Tgt struct {
@@ -25,11 +35,13 @@ type Tgt struct {
 	scsiTimeout               time.Duration
 	iscsiAbortTimeout         time.Duration
 	iscsiTargetRequestTimeout time.Duration
+	chap                      *ChapCredentials
+	emulate512e               bool
 }
 
-func New(frontendName string, scsiTimeout, iscsiAbortTimeout, iscsiTargetRequestTimeout time.Duration) types.Frontend {
+func New(frontendName string, scsiTimeout, iscsiAbortTimeout, iscsiTargetRequestTimeout time.Duration, chap *ChapCredentials, emulate512e bool) types.Frontend {
 	s := socket.New()
-	return &Tgt{s, false, nil, frontendName, scsiTimeout, iscsiAbortTimeout, iscsiTargetRequestTimeout}
+	return &Tgt{s, false, nil, frontendName, scsiTimeout, iscsiAbortTimeout, iscsiTargetRequestTimeout, chap, emulate512e}
 }
 
 func (t *Tgt) FrontendName() string {
@@ -37,6 +49,19 @@ func (t *Tgt) FrontendName() string {
 }
 
 func (t *Tgt) Init(name string, size, sectorSize int64) error {
+	if t.chap.Enabled() {
+		if t.frontendName != devtypes.FrontendTGTISCSI {
+			return fmt.Errorf("CHAP authentication is only supported by frontend %v", devtypes.FrontendTGTISCSI)
+		}
+		if err := t.chap.Validate(); err != nil {
+			return err
+		}
+		// Try to cleanup possible leftovers of a previous run.
+		if err := cleanupChap(name, t.chap); err != nil {
+			return err
+		}
+	}
+
 	if err := t.s.Init(name, size, sectorSize); err != nil {
 		return err
 	}
@@ -59,20 +84,91 @@ func (t *Tgt) Init(name string, size, sectorSize int64) error {
 	return nil
 }
 
-func (t *Tgt) Startup(rwu types.ReaderWriterUnmapperAt) error {
+func (t *Tgt) Startup(rwu types.ReaderWriterUnmapperAt) (err error) {
 	if err := t.s.Startup(rwu); err != nil {
 		return err
 	}
 
-	if err := t.dev.Start(); err != nil {
+	devStarting := false
+	defer func() {
+		if err != nil {
+			// Do not leave the target exported without authentication
+			// or with a half-applied configuration.
+			t.cleanupFailedStartup(devStarting)
+		}
+	}()
+
+	if t.chap.Enabled() {
+		if err = createChapAccounts(t.chap); err != nil {
+			return err
+		}
+	}
+
+	devStarting = true
+	if err = t.dev.Start(); err != nil {
 		return err
 	}
 
+	if t.emulate512e {
+		if err = t.set512ePhysicalBlockSize(); err != nil {
+			return err
+		}
+	}
+
+	if t.chap.Enabled() {
+		if err = bindChapAccounts(t.s.Volume, t.chap); err != nil {
+			return err
+		}
+	}
+
 	t.isUp = true
 
 	return nil
 }
 
+func (t *Tgt) cleanupFailedStartup(devStarting bool) {
+	if devStarting {
+		if err := t.dev.Shutdown(); err != nil {
+			logrus.WithError(err).Warnf("Failed to shut down device of volume %v after failed startup", t.s.Volume)
+		}
+	}
+	if err := t.s.Shutdown(); err != nil {
+		logrus.WithError(err).Warnf("Failed to shut down socket of volume %v after failed startup", t.s.Volume)
+	}
+	if t.chap.Enabled() {
+		if err := cleanupChap(t.s.Volume, t.chap); err != nil {
+			logrus.WithError(err).Warnf("Failed to clean up CHAP accounts of volume %v after failed startup", t.s.Volume)
+		}
+	}
+}
+
+// set512ePhysicalBlockSize makes the LUN report 4096-byte physical blocks
+// while keeping the 512-byte logical block size of tgt. The local initiator
+// of the block device frontend has logged in already, so it rescans the
+// target to pick up the new geometry.
+func (t *Tgt) set512ePhysicalBlockSize() error {
+	target := iscsidev.GetTargetName(t.s.Volume)
+	tid, err := iscsi.GetTargetTid(target)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get target ID of volume %v", t.s.Volume)
+	}
+	if tid == -1 {
+		return fmt.Errorf("cannot find target of volume %v", t.s.Volume)
+	}
+	if err := iscsi.UpdateLun(tid, iscsidev.TargetLunID, map[string]string{"lbppbe": lbppbe512e}); err != nil {
+		return errors.Wrapf(err, "failed to set physical block size of target %v", target)
+	}
+
+	if t.frontendName != devtypes.FrontendTGTBlockDev {
+		return nil
+	}
+	scsiDev, err := iscsidev.NewDevice(t.s.Volume, "", "", "", 0, 0)
+	if err != nil {
+		return err
+	}
+	return errors.Wrapf(scsiDev.RefreshInitiator(), "failed to rescan target %v", target)
+}
+
 func (t *Tgt) Shutdown() error {
 	if t.dev != nil {
 		if err := t.dev.Shutdown(); err != nil {
@@ -82,6 +178,11 @@ func (t *Tgt) Shutdown() error {
 	if err := t.s.Shutdown(); err != nil {
 		return err
 	}
+	if t.chap.Enabled() {
+		if err := cleanupChap(t.s.Volume, t.chap); err != nil {
+			return err
+		}
+	}
 	t.isUp = false
 
 	return nil
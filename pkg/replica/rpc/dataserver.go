@@ -1,6 +1,7 @@
 package rpc
 
 import (
+	"crypto/tls"
 	"fmt"
 	"net"
 
@@ -12,16 +13,21 @@ import (
 )
 
 type DataServer struct {
-	protocol types.DataServerProtocol
-	address  string
-	s        *replica.Server
+	protocol  types.DataServerProtocol
+	address   string
+	s         *replica.Server
+	tlsConfig *tls.Config
 }
 
-func NewDataServer(protocol types.DataServerProtocol, address string, s *replica.Server) *DataServer {
+// NewDataServer creates the replica data server. If tlsConfig is not nil,
+// TCP connections are served over TLS. Unix domain socket connections never
+// leave the node and are always served in cleartext.
+func NewDataServer(protocol types.DataServerProtocol, address string, s *replica.Server, tlsConfig *tls.Config) *DataServer {
 	return &DataServer{
-		protocol: protocol,
-		address:  address,
-		s:        s,
+		protocol:  protocol,
+		address:   address,
+		s:         s,
+		tlsConfig: tlsConfig,
 	}
 }
 
@@ -57,6 +63,9 @@ func (s *DataServer) listenAndServeTCP() error {
 		logrus.Infof("New connection from: %v", conn.RemoteAddr())
 
 		go func(conn net.Conn) {
+			if s.tlsConfig != nil {
+				conn = tls.Server(conn, s.tlsConfig)
+			}
 			server := dataconn.NewServer(conn, s.s)
 			server.Handle()
 		}(conn)
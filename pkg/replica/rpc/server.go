@@ -28,14 +28,21 @@ type ReplicaHealthCheckServer struct {
 	rs *ReplicaServer
 }
 
-func NewReplicaServer(volumeName, instanceName string, s *replica.Server) *grpc.Server {
+func NewReplicaServer(volumeName, instanceName string, s *replica.Server) (*grpc.Server, error) {
+	creds, err := ptypes.WithServerTransportCredentials()
+	if err != nil {
+		return nil, err
+	}
 	rs := &ReplicaServer{s: s}
-	server := grpc.NewServer(ptypes.WithIdentityValidationReplicaServerInterceptor(volumeName, instanceName))
+	server := grpc.NewServer(ptypes.WithAuditServerInterceptor(),
+		ptypes.WithIdentityValidationReplicaServerInterceptor(volumeName, instanceName),
+		ptypes.WithControlTokenServerInterceptor(), creds)
 	ptypes.RegisterReplicaServiceServer(server, rs)
+	ptypes.RegisterLogServiceServer(server, ptypes.NewLogServer())
 	healthpb.RegisterHealthServer(server, NewReplicaHealthCheckServer(rs))
 	reflection.Register(server)
 	profilerpb.RegisterProfilerServer(server, profiler.NewServer(volumeName))
-	return server
+	return server, nil
 }
 
 func NewReplicaHealthCheckServer(rs *ReplicaServer) *ReplicaHealthCheckServer {
@@ -264,26 +271,39 @@ func (rs *ReplicaServer) SnapshotMaxSizeSet(ctx context.Context, req *ptypes.Sna
 	return &ptypes.SnapshotMaxSizeSetResponse{Replica: rs.getReplica()}, nil
 }
 
-func (hc *ReplicaHealthCheckServer) Check(context.Context, *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
-	if hc.rs.s != nil {
-		return &healthpb.HealthCheckResponse{
-			Status: healthpb.HealthCheckResponse_SERVING,
-		}, nil
+// servingStatus returns the status of service. The empty service is the
+// replica process itself, the named one reports whether the replica is open
+// and not being rebuilt.
+func (hc *ReplicaHealthCheckServer) servingStatus(service string) healthpb.HealthCheckResponse_ServingStatus {
+	if hc.rs.s == nil {
+		return healthpb.HealthCheckResponse_NOT_SERVING
 	}
 
+	switch service {
+	case "":
+		return healthpb.HealthCheckResponse_SERVING
+	case types.HealthServiceReplica:
+		if state, _ := hc.rs.s.Status(); state != types.ReplicaStateOpen && state != types.ReplicaStateDirty {
+			return healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		return healthpb.HealthCheckResponse_SERVING
+	default:
+		return healthpb.HealthCheckResponse_SERVICE_UNKNOWN
+	}
+}
+
+func (hc *ReplicaHealthCheckServer) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	servingStatus := hc.servingStatus(req.Service)
+	if servingStatus == healthpb.HealthCheckResponse_SERVICE_UNKNOWN {
+		return nil, status.Errorf(codes.NotFound, "unknown health service %v", req.Service)
+	}
 	return &healthpb.HealthCheckResponse{
-		Status: healthpb.HealthCheckResponse_NOT_SERVING,
+		Status: servingStatus,
 	}, nil
 }
 
 func (hc *ReplicaHealthCheckServer) Watch(req *healthpb.HealthCheckRequest, ws healthpb.Health_WatchServer) error {
-	if hc.rs.s != nil {
-		return ws.Send(&healthpb.HealthCheckResponse{
-			Status: healthpb.HealthCheckResponse_SERVING,
-		})
-	}
-
-	return ws.Send(&healthpb.HealthCheckResponse{
-		Status: healthpb.HealthCheckResponse_NOT_SERVING,
+	return ptypes.WatchHealth(ws, func() healthpb.HealthCheckResponse_ServingStatus {
+		return hc.servingStatus(req.Service)
 	})
 }
@@ -18,7 +18,6 @@ import (
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/protobuf/types/known/emptypb"
 
@@ -132,7 +131,11 @@ func (cs *CloneStatus) UpdateSyncFileProgress(size int64) {
 	cs.Progress = int((float32(cs.processedSize) / float32(cs.totalSize)) * 100)
 }
 
-func NewSyncAgentServer(startPort, endPort int, replicaAddress, volumeName, instanceName string) *grpc.Server {
+func NewSyncAgentServer(startPort, endPort int, replicaAddress, volumeName, instanceName string) (*grpc.Server, error) {
+	creds, err := ptypes.WithServerTransportCredentials()
+	if err != nil {
+		return nil, err
+	}
 	sas := &SyncAgentServer{
 		currentPort:     startPort,
 		startPort:       startPort,
@@ -149,10 +152,13 @@ func NewSyncAgentServer(startPort, endPort int, replicaAddress, volumeName, inst
 		RebuildStatus:    &RebuildStatus{},
 		CloneStatus:      &CloneStatus{},
 	}
-	server := grpc.NewServer(ptypes.WithIdentityValidationReplicaServerInterceptor(volumeName, instanceName))
+	server := grpc.NewServer(ptypes.WithAuditServerInterceptor(),
+		ptypes.WithIdentityValidationReplicaServerInterceptor(volumeName, instanceName),
+		ptypes.WithControlTokenServerInterceptor(), creds)
 	ptypes.RegisterSyncAgentServiceServer(server, sas)
+	ptypes.RegisterLogServiceServer(server, ptypes.NewLogServer())
 	reflection.Register(server)
-	return server
+	return server, nil
 }
 
 func (s *SyncAgentServer) nextPort(processName string) (int, error) {
@@ -966,7 +972,7 @@ func (s *SyncAgentServer) postIncrementalRestoreOperations(restoreStatus *replic
 }
 
 func (s *SyncAgentServer) reloadReplica() error {
-	conn, err := grpc.Dial(s.replicaAddress, grpc.WithTransportCredentials(insecure.NewCredentials()),
+	conn, err := grpc.Dial(s.replicaAddress, ptypes.WithClientTransportCredentials(),
 		ptypes.WithIdentityValidationClientInterceptor(s.volumeName, s.instanceName))
 	if err != nil {
 		return errors.Wrapf(err, "cannot connect to ReplicaService %v", s.replicaAddress)
@@ -985,7 +991,7 @@ func (s *SyncAgentServer) reloadReplica() error {
 }
 
 func (s *SyncAgentServer) replicaRevert(name, created string) error {
-	conn, err := grpc.Dial(s.replicaAddress, grpc.WithTransportCredentials(insecure.NewCredentials()),
+	conn, err := grpc.Dial(s.replicaAddress, ptypes.WithClientTransportCredentials(),
 		ptypes.WithIdentityValidationClientInterceptor(s.volumeName, s.instanceName))
 	if err != nil {
 		return errors.Wrapf(err, "cannot connect to ReplicaService %v", s.replicaAddress)
@@ -1283,7 +1289,7 @@ func getSnapshotsInfo(replicaClient *replicaclient.ReplicaClient) (map[string]ty
 }
 
 func (s *SyncAgentServer) markSnapshotAsRemoved(snapshot string) error {
-	conn, err := grpc.Dial(s.replicaAddress, grpc.WithTransportCredentials(insecure.NewCredentials()),
+	conn, err := grpc.Dial(s.replicaAddress, ptypes.WithClientTransportCredentials(),
 		ptypes.WithIdentityValidationClientInterceptor(s.volumeName, s.instanceName))
 	if err != nil {
 		return errors.Wrapf(err, "cannot connect to ReplicaService %v", s.replicaAddress)
@@ -1304,7 +1310,7 @@ func (s *SyncAgentServer) markSnapshotAsRemoved(snapshot string) error {
 }
 
 func (s *SyncAgentServer) processRemoveSnapshot(snapshot string) error {
-	conn, err := grpc.Dial(s.replicaAddress, grpc.WithTransportCredentials(insecure.NewCredentials()),
+	conn, err := grpc.Dial(s.replicaAddress, ptypes.WithClientTransportCredentials(),
 		ptypes.WithIdentityValidationClientInterceptor(s.volumeName, s.instanceName))
 	if err != nil {
 		return errors.Wrapf(err, "cannot connect to ReplicaService %v", s.replicaAddress)
@@ -1355,7 +1361,7 @@ func (s *SyncAgentServer) processRemoveSnapshot(snapshot string) error {
 }
 
 func (s *SyncAgentServer) replaceDisk(source, target string) error {
-	conn, err := grpc.Dial(s.replicaAddress, grpc.WithTransportCredentials(insecure.NewCredentials()),
+	conn, err := grpc.Dial(s.replicaAddress, ptypes.WithClientTransportCredentials(),
 		ptypes.WithIdentityValidationClientInterceptor(s.volumeName, s.instanceName))
 	if err != nil {
 		return errors.Wrapf(err, "cannot connect to ReplicaService %v", s.replicaAddress)
@@ -1377,7 +1383,7 @@ func (s *SyncAgentServer) replaceDisk(source, target string) error {
 }
 
 func (s *SyncAgentServer) rmDisk(disk string) error {
-	conn, err := grpc.Dial(s.replicaAddress, grpc.WithTransportCredentials(insecure.NewCredentials()),
+	conn, err := grpc.Dial(s.replicaAddress, ptypes.WithClientTransportCredentials(),
 		ptypes.WithIdentityValidationClientInterceptor(s.volumeName, s.instanceName))
 	if err != nil {
 		return errors.Wrapf(err, "cannot connect to ReplicaService %v", s.replicaAddress)
@@ -51,6 +51,8 @@ type VolumeInfo struct {
 	UnmapMarkSnapChainRemoved bool   `json:"unmapMarkSnapChainRemoved"`
 	SnapshotMaxCount          int    `json:"snapshotMaxCount"`
 	SnapshotMaxSize           int64  `json:"SnapshotMaxSize"`
+	IOPSLimit                 int64  `json:"iopsLimit"`
+	BandwidthLimit            int64  `json:"bandwidthLimit"`
 }
 
 type ControllerReplicaInfo struct {
@@ -58,6 +60,16 @@ type ControllerReplicaInfo struct {
 	Mode    Mode   `json:"mode"`
 }
 
+// VolumeEvent is a change of the volume or of one of its replicas, as
+// streamed by the controller.
+type VolumeEvent struct {
+	Type           string `json:"type"`
+	VolumeName     string `json:"volumeName"`
+	ReplicaAddress string `json:"replicaAddress,omitempty"`
+	Message        string `json:"message,omitempty"`
+	Time           string `json:"time"`
+}
+
 type SyncFileInfo struct {
 	FromFileName string `json:"fromFileName"`
 	ToFileName   string `json:"toFileName"`
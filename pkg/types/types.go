@@ -64,6 +64,31 @@ const (
 	ReplicaStateError      = ReplicaState("error")
 )
 
+// VolumeHealth tells whether a volume can serve IO and with what redundancy.
+type VolumeHealth string
+
+const (
+	// VolumeHealthHealthy means all replicas are RW.
+	VolumeHealthHealthy = VolumeHealth("healthy")
+	// VolumeHealthDegraded means some replicas are RW and others are being
+	// rebuilt or failed.
+	VolumeHealthDegraded = VolumeHealth("degraded")
+	// VolumeHealthFaulted means no replica is RW, so IO cannot be served.
+	VolumeHealthFaulted = VolumeHealth("faulted")
+)
+
+const (
+	// HealthServiceVolume is the gRPC health service name the controller
+	// reports as SERVING while the volume can serve IO, even if degraded.
+	HealthServiceVolume = "longhorn.engine.Volume"
+	// HealthServiceVolumeHealthy is the gRPC health service name the
+	// controller reports as SERVING only while the volume is healthy.
+	HealthServiceVolumeHealthy = "longhorn.engine.VolumeHealthy"
+	// HealthServiceReplica is the gRPC health service name a replica reports
+	// as SERVING while it is open and not being rebuilt.
+	HealthServiceReplica = "longhorn.engine.Replica"
+)
+
 type ReaderWriterUnmapperAt interface {
 	io.ReaderAt
 	io.WriterAt
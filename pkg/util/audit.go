@@ -0,0 +1,85 @@
+package util
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// AuditRecord is one control operation in the audit log. Target describes
+// what the operation acted on, e.g. "replica=tcp://10.0.0.1:10000".
+type AuditRecord struct {
+	Time     time.Time     `json:"time"`
+	Method   string        `json:"method"`
+	Target   string        `json:"target,omitempty"`
+	Caller   string        `json:"caller"`
+	Code     string        `json:"code"`
+	Error    string        `json:"error,omitempty"`
+	Duration time.Duration `json:"duration"`
+}
+
+// AuditLog appends AuditRecords to a file, one JSON object per line. Each
+// record is synced to disk before Record returns.
+type AuditLog struct {
+	sync.Mutex
+
+	file *os.File
+}
+
+func OpenAuditLog(path string) (*AuditLog, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open audit log %v", path)
+	}
+	return &AuditLog{file: file}, nil
+}
+
+func (l *AuditLog) Record(record *AuditRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	l.Lock()
+	defer l.Unlock()
+
+	// A single write per record keeps lines from several processes sharing
+	// the file from interleaving.
+	if _, err := l.file.Write(line); err != nil {
+		return errors.Wrapf(err, "failed to write audit log %v", l.file.Name())
+	}
+	return l.file.Sync()
+}
+
+func (l *AuditLog) Close() error {
+	return l.file.Close()
+}
+
+// ReadAuditLog returns the records of the audit log at path. Lines that cannot
+// be parsed, like one cut short by a crash, are skipped.
+func ReadAuditLog(path string) ([]AuditRecord, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open audit log %v", path)
+	}
+	defer file.Close()
+
+	records := []AuditRecord{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		record := AuditRecord{}
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			continue
+		}
+		records = append(records, record)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to read audit log %v", path)
+	}
+	return records, nil
+}
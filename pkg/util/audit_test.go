@@ -0,0 +1,46 @@
+package util
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestAuditLog(c *C) {
+	path := filepath.Join(c.MkDir(), "audit.log")
+
+	l, err := OpenAuditLog(path)
+	c.Assert(err, IsNil)
+	now := time.Now().UTC().Truncate(time.Second)
+	c.Assert(l.Record(&AuditRecord{Time: now, Method: "/ptypes.ControllerService/VolumeSnapshot", Target: "snapshot=snap-1",
+		Caller: "10.0.0.1:1234", Code: "OK"}), IsNil)
+	c.Assert(l.Close(), IsNil)
+
+	// Records are appended across reopens, and a torn line is skipped
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0600)
+	c.Assert(err, IsNil)
+	_, err = f.WriteString("{\"time\":\n")
+	c.Assert(err, IsNil)
+	c.Assert(f.Close(), IsNil)
+
+	l, err = OpenAuditLog(path)
+	c.Assert(err, IsNil)
+	c.Assert(l.Record(&AuditRecord{Time: now, Method: "/ptypes.ControllerService/ReplicaDelete", Caller: "10.0.0.1:1234",
+		Code: "PermissionDenied", Error: "missing control token"}), IsNil)
+	c.Assert(l.Close(), IsNil)
+
+	records, err := ReadAuditLog(path)
+	c.Assert(err, IsNil)
+	c.Assert(records, HasLen, 2)
+	c.Assert(records[0].Method, Equals, "/ptypes.ControllerService/VolumeSnapshot")
+	c.Assert(records[0].Target, Equals, "snapshot=snap-1")
+	c.Assert(records[0].Time.Equal(now), Equals, true)
+	c.Assert(records[1].Code, Equals, "PermissionDenied")
+	c.Assert(records[1].Error, Equals, "missing control token")
+
+	info, err := os.Stat(path)
+	c.Assert(err, IsNil)
+	c.Assert(info.Mode().Perm(), Equals, os.FileMode(0600))
+}
@@ -0,0 +1,59 @@
+package util
+
+import (
+	"expvar"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+var publishDebugVarsOnce sync.Once
+
+func publishDebugVars() {
+	startTime := time.Now()
+	expvar.Publish("goroutines", expvar.Func(func() any {
+		return runtime.NumGoroutine()
+	}))
+	expvar.Publish("uptimeSeconds", expvar.Func(func() any {
+		return int64(time.Since(startTime).Seconds())
+	}))
+}
+
+// NewDebugHandler returns the handler of the debug server. It serves the pprof
+// profiles under /debug/pprof/ and the expvar variables under /debug/vars.
+// Full goroutine dumps are at /debug/pprof/goroutine?debug=2.
+func NewDebugHandler() http.Handler {
+	publishDebugVarsOnce.Do(publishDebugVars)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.Handle("/debug/vars", expvar.Handler())
+	return mux
+}
+
+// StartDebugServer serves NewDebugHandler on address in the background. The
+// endpoints are unauthenticated, so address should normally be a loopback
+// one.
+func StartDebugServer(address string) error {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return errors.Wrapf(err, "failed to listen on debug address %v", address)
+	}
+
+	go func() {
+		logrus.Infof("Listening on debug server %v", listener.Addr())
+		err := http.Serve(listener, NewDebugHandler())
+		logrus.WithError(err).Warnf("Debug server at %v is down", listener.Addr())
+	}()
+	return nil
+}
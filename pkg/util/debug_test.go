@@ -0,0 +1,30 @@
+package util
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestDebugHandler(c *C) {
+	handler := NewDebugHandler()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/vars", nil))
+	c.Assert(rec.Code, Equals, http.StatusOK)
+	vars := map[string]any{}
+	c.Assert(json.Unmarshal(rec.Body.Bytes(), &vars), IsNil)
+	c.Assert(vars["goroutines"], NotNil)
+	c.Assert(vars["uptimeSeconds"], NotNil)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/pprof/goroutine?debug=2", nil))
+	c.Assert(rec.Code, Equals, http.StatusOK)
+	c.Assert(strings.Contains(rec.Body.String(), "TestDebugHandler"), Equals, true)
+
+	// A second handler must not publish the variables again
+	c.Assert(NewDebugHandler(), NotNil)
+}
@@ -0,0 +1,28 @@
+package util
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+// LogFieldsHook adds fixed fields, like the volume a process serves, to every
+// log entry. Fields set by the entry itself take precedence.
+type LogFieldsHook struct {
+	fields logrus.Fields
+}
+
+func NewLogFieldsHook(fields logrus.Fields) *LogFieldsHook {
+	return &LogFieldsHook{fields: fields}
+}
+
+func (h *LogFieldsHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (h *LogFieldsHook) Fire(entry *logrus.Entry) error {
+	for key, value := range h.fields {
+		if _, ok := entry.Data[key]; !ok {
+			entry.Data[key] = value
+		}
+	}
+	return nil
+}
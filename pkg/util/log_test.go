@@ -0,0 +1,28 @@
+package util
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/sirupsen/logrus"
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestLogFieldsHook(c *C) {
+	buf := &bytes.Buffer{}
+	logger := logrus.New()
+	logger.SetOutput(buf)
+	logger.SetFormatter(&logrus.JSONFormatter{})
+	logger.AddHook(NewLogFieldsHook(logrus.Fields{"volume": "test-volume"}))
+
+	logger.Info("first")
+	logger.WithField("volume", "other-volume").Info("second")
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	c.Assert(lines, HasLen, 2)
+	entry := map[string]any{}
+	c.Assert(json.Unmarshal(lines[0], &entry), IsNil)
+	c.Assert(entry["volume"], Equals, "test-volume")
+	c.Assert(json.Unmarshal(lines[1], &entry), IsNil)
+	c.Assert(entry["volume"], Equals, "other-volume")
+}
@@ -0,0 +1,215 @@
+package util
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// TLSReloadCheckInterval is how often a TLSReloader looks for changes of
+	// its files. It bounds how long a rotated certificate takes to be used.
+	TLSReloadCheckInterval = 10 * time.Second
+)
+
+// TLSFiles holds the PEM files used to set up a TLS connection. CAFile is
+// used to verify the peer. On a server it also turns on client certificate
+// verification.
+type TLSFiles struct {
+	CertFile string
+	KeyFile  string
+	CAFile   string
+}
+
+func (f *TLSFiles) Enabled() bool {
+	return f != nil && (f.CertFile != "" || f.KeyFile != "" || f.CAFile != "")
+}
+
+func (f *TLSFiles) loadCertificate() (*tls.Certificate, error) {
+	if f.CertFile == "" && f.KeyFile == "" {
+		return nil, nil
+	}
+	if f.CertFile == "" || f.KeyFile == "" {
+		return nil, errors.New("both the TLS certificate and key files are required")
+	}
+	cert, err := tls.LoadX509KeyPair(f.CertFile, f.KeyFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load TLS key pair %v and %v", f.CertFile, f.KeyFile)
+	}
+	return &cert, nil
+}
+
+func (f *TLSFiles) loadCertPool() (*x509.CertPool, error) {
+	if f.CAFile == "" {
+		return nil, nil
+	}
+	pem, err := os.ReadFile(f.CAFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read TLS CA file %v", f.CAFile)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, errors.Errorf("failed to find any PEM certificate in TLS CA file %v", f.CAFile)
+	}
+	return pool, nil
+}
+
+func (f *TLSFiles) load() (*tls.Certificate, *x509.CertPool, error) {
+	cert, err := f.loadCertificate()
+	if err != nil {
+		return nil, nil, err
+	}
+	pool, err := f.loadCertPool()
+	if err != nil {
+		return nil, nil, err
+	}
+	return cert, pool, nil
+}
+
+func (f *TLSFiles) modTimes() []time.Time {
+	var modTimes []time.Time
+	for _, file := range []string{f.CertFile, f.KeyFile, f.CAFile} {
+		var modTime time.Time
+		if file != "" {
+			if info, err := os.Stat(file); err == nil {
+				modTime = info.ModTime()
+			}
+		}
+		modTimes = append(modTimes, modTime)
+	}
+	return modTimes
+}
+
+func newServerTLSConfig(cert *tls.Certificate, pool *x509.CertPool) (*tls.Config, error) {
+	if cert == nil {
+		return nil, errors.New("TLS server requires a certificate and key")
+	}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{*cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if pool != nil {
+		config.ClientCAs = pool
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return config, nil
+}
+
+func newClientTLSConfig(cert *tls.Certificate, pool *x509.CertPool, serverName string) *tls.Config {
+	config := &tls.Config{
+		RootCAs:    pool,
+		ServerName: serverName,
+		MinVersion: tls.VersionTLS12,
+	}
+	if cert != nil {
+		config.Certificates = []tls.Certificate{*cert}
+	}
+	return config
+}
+
+// NewServerTLSConfig returns the TLS configuration of a server. A certificate
+// is mandatory, clients are required to present a certificate signed by the
+// CA if a CA file is given.
+func NewServerTLSConfig(files *TLSFiles) (*tls.Config, error) {
+	cert, pool, err := files.load()
+	if err != nil {
+		return nil, err
+	}
+	return newServerTLSConfig(cert, pool)
+}
+
+// NewClientTLSConfig returns the TLS configuration of a client. The server is
+// verified against the CA file or, if there is none, the system roots.
+// serverName overrides the name checked in the server certificate, which
+// otherwise defaults to the host part of the dialed address.
+func NewClientTLSConfig(files *TLSFiles, serverName string) (*tls.Config, error) {
+	cert, pool, err := files.load()
+	if err != nil {
+		return nil, err
+	}
+	return newClientTLSConfig(cert, pool, serverName), nil
+}
+
+// TLSReloader hands out TLS configurations that follow changes of the
+// underlying files, so certificates can be rotated without a restart. A
+// failed reload is logged and the previously loaded files stay in use.
+type TLSReloader struct {
+	sync.Mutex
+
+	files     TLSFiles
+	cert      *tls.Certificate
+	pool      *x509.CertPool
+	modTimes  []time.Time
+	lastCheck time.Time
+}
+
+func NewTLSReloader(files *TLSFiles) (*TLSReloader, error) {
+	cert, pool, err := files.load()
+	if err != nil {
+		return nil, err
+	}
+	return &TLSReloader{
+		files:     *files,
+		cert:      cert,
+		pool:      pool,
+		modTimes:  files.modTimes(),
+		lastCheck: time.Now(),
+	}, nil
+}
+
+func (r *TLSReloader) current() (*tls.Certificate, *x509.CertPool) {
+	r.Lock()
+	defer r.Unlock()
+
+	if time.Since(r.lastCheck) < TLSReloadCheckInterval {
+		return r.cert, r.pool
+	}
+	r.lastCheck = time.Now()
+
+	modTimes := r.files.modTimes()
+	changed := false
+	for i := range modTimes {
+		if !modTimes[i].Equal(r.modTimes[i]) {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return r.cert, r.pool
+	}
+
+	cert, pool, err := r.files.load()
+	if err != nil {
+		logrus.WithError(err).Warn("Failed to reload TLS files, keep using the previous ones")
+		return r.cert, r.pool
+	}
+	logrus.Infof("Reloaded TLS files %+v", r.files)
+	r.cert, r.pool, r.modTimes = cert, pool, modTimes
+	return r.cert, r.pool
+}
+
+// ServerConfig returns a server configuration that picks up the current
+// files for every new connection.
+func (r *TLSReloader) ServerConfig() (*tls.Config, error) {
+	cert, pool := r.current()
+	config, err := newServerTLSConfig(cert, pool)
+	if err != nil {
+		return nil, err
+	}
+	config.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		return newServerTLSConfig(r.current())
+	}
+	return config, nil
+}
+
+// ClientConfig returns a client configuration built from the current files.
+// It is meant to be requested for every dial.
+func (r *TLSReloader) ClientConfig(serverName string) *tls.Config {
+	cert, pool := r.current()
+	return newClientTLSConfig(cert, pool, serverName)
+}
@@ -0,0 +1,175 @@
+package util
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCert(c *C, template *x509.Certificate, parent *testCert) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	c.Assert(err, IsNil)
+
+	parentCert, parentKey := template, key
+	if parent != nil {
+		parentCert, parentKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parentCert, &key.PublicKey, parentKey)
+	c.Assert(err, IsNil)
+	cert, err := x509.ParseCertificate(der)
+	c.Assert(err, IsNil)
+	return &testCert{cert: cert, key: key}
+}
+
+func (t *testCert) write(c *C, dir, name string) (string, string) {
+	certFile := filepath.Join(dir, name+".crt")
+	keyFile := filepath.Join(dir, name+".key")
+	err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: t.cert.Raw}), 0600)
+	c.Assert(err, IsNil)
+	keyDer, err := x509.MarshalECPrivateKey(t.key)
+	c.Assert(err, IsNil)
+	err = os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600)
+	c.Assert(err, IsNil)
+	return certFile, keyFile
+}
+
+func serialOf(c *C, cert *tls.Certificate) int64 {
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	c.Assert(err, IsNil)
+	return leaf.SerialNumber.Int64()
+}
+
+func certTemplate(serial int64, name string, isCA bool) *x509.Certificate {
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	if isCA {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+		template.IPAddresses = nil
+	}
+	return template
+}
+
+func tlsHandshake(c *C, serverConfig, clientConfig *tls.Config) (error, error) {
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+	c.Assert(err, IsNil)
+	defer ln.Close()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			serverErr <- err
+			return
+		}
+		defer conn.Close()
+		serverErr <- conn.(*tls.Conn).Handshake()
+	}()
+
+	conn, clientErr := tls.Dial("tcp", ln.Addr().String(), clientConfig)
+	if clientErr == nil {
+		conn.Close()
+	}
+	return <-serverErr, clientErr
+}
+
+func (s *TestSuite) TestTLSConfig(c *C) {
+	dir := c.MkDir()
+	ca := newTestCert(c, certTemplate(1, "ca", true), nil)
+	caFile, _ := ca.write(c, dir, "ca")
+	serverCertFile, serverKeyFile := newTestCert(c, certTemplate(2, "replica", false), ca).write(c, dir, "server")
+	clientCertFile, clientKeyFile := newTestCert(c, certTemplate(3, "controller", false), ca).write(c, dir, "client")
+
+	c.Assert((&TLSFiles{}).Enabled(), Equals, false)
+
+	_, err := NewServerTLSConfig(&TLSFiles{CAFile: caFile})
+	c.Assert(err, NotNil)
+	_, err = NewClientTLSConfig(&TLSFiles{CertFile: clientCertFile, CAFile: caFile}, "")
+	c.Assert(err, NotNil)
+
+	serverConfig, err := NewServerTLSConfig(&TLSFiles{CertFile: serverCertFile, KeyFile: serverKeyFile, CAFile: caFile})
+	c.Assert(err, IsNil)
+
+	clientConfig, err := NewClientTLSConfig(&TLSFiles{CertFile: clientCertFile, KeyFile: clientKeyFile, CAFile: caFile}, "")
+	c.Assert(err, IsNil)
+	serverErr, clientErr := tlsHandshake(c, serverConfig, clientConfig)
+	c.Assert(serverErr, IsNil)
+	c.Assert(clientErr, IsNil)
+
+	// The server requires a client certificate once a CA is configured
+	clientConfig, err = NewClientTLSConfig(&TLSFiles{CAFile: caFile}, "")
+	c.Assert(err, IsNil)
+	serverErr, _ = tlsHandshake(c, serverConfig, clientConfig)
+	c.Assert(serverErr, NotNil)
+
+	// The client verifies the server name against the certificate
+	clientConfig, err = NewClientTLSConfig(&TLSFiles{CertFile: clientCertFile, KeyFile: clientKeyFile, CAFile: caFile}, "other-replica")
+	c.Assert(err, IsNil)
+	_, clientErr = tlsHandshake(c, serverConfig, clientConfig)
+	c.Assert(clientErr, NotNil)
+}
+
+func (s *TestSuite) TestTLSReloader(c *C) {
+	dir := c.MkDir()
+	ca := newTestCert(c, certTemplate(1, "ca", true), nil)
+	caFile, _ := ca.write(c, dir, "ca")
+	certFile, keyFile := newTestCert(c, certTemplate(2, "replica", false), ca).write(c, dir, "server")
+
+	reloader, err := NewTLSReloader(&TLSFiles{CertFile: certFile, KeyFile: keyFile, CAFile: caFile})
+	c.Assert(err, IsNil)
+	cert, _ := reloader.current()
+	c.Assert(serialOf(c, cert), Equals, int64(2))
+
+	// Rotate the certificate and force the next access to check the files
+	newTestCert(c, certTemplate(3, "replica", false), ca).write(c, dir, "server")
+	future := time.Now().Add(time.Minute)
+	c.Assert(os.Chtimes(certFile, future, future), IsNil)
+	reloader.lastCheck = time.Time{}
+	cert, _ = reloader.current()
+	c.Assert(serialOf(c, cert), Equals, int64(3))
+
+	// A broken file keeps the previous certificate in use
+	c.Assert(os.WriteFile(keyFile, []byte("invalid"), 0600), IsNil)
+	future = future.Add(time.Minute)
+	c.Assert(os.Chtimes(keyFile, future, future), IsNil)
+	reloader.lastCheck = time.Time{}
+	cert, _ = reloader.current()
+	c.Assert(serialOf(c, cert), Equals, int64(3))
+
+	serverConfig, err := reloader.ServerConfig()
+	c.Assert(err, IsNil)
+	// Clients without a certificate are rejected
+	serverErr, _ := tlsHandshake(c, serverConfig, &tls.Config{RootCAs: reloader.pool})
+	c.Assert(serverErr, NotNil)
+
+	clientCertFile, clientKeyFile := newTestCert(c, certTemplate(4, "controller", false), ca).write(c, dir, "client")
+	clientReloader, err := NewTLSReloader(&TLSFiles{CertFile: clientCertFile, KeyFile: clientKeyFile, CAFile: caFile})
+	c.Assert(err, IsNil)
+	serverErr, clientErr := tlsHandshake(c, serverConfig, clientReloader.ClientConfig(""))
+	c.Assert(serverErr, IsNil)
+	c.Assert(clientErr, IsNil)
+}
@@ -0,0 +1,62 @@
+package util
+
+import (
+	"sync"
+	"time"
+)
+
+// TokenBucket limits the rate of a resource, like IO operations or bytes. It
+// refills at rate tokens per second up to burst tokens. A request larger than
+// what is available is let through once the deficit has been refilled, so
+// requests larger than burst do not block forever.
+type TokenBucket struct {
+	sync.Mutex
+
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+
+	// sleep is replaceable for tests
+	sleep func(time.Duration)
+}
+
+func NewTokenBucket(rate, burst int64) *TokenBucket {
+	if burst < rate {
+		burst = rate
+	}
+	return &TokenBucket{
+		rate:   float64(rate),
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+		sleep:  time.Sleep,
+	}
+}
+
+// reserve takes n tokens and returns how long the caller has to wait before
+// using them. The tokens may go negative, which makes later callers wait for
+// the debt to be paid off first, keeping the callers in order.
+func (b *TokenBucket) reserve(n int64, now time.Time) time.Duration {
+	b.Lock()
+	defer b.Unlock()
+
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+
+	b.tokens -= float64(n)
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// Wait blocks until n tokens are available and takes them.
+func (b *TokenBucket) Wait(n int64) {
+	if wait := b.reserve(n, time.Now()); wait > 0 {
+		b.sleep(wait)
+	}
+}
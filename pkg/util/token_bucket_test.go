@@ -0,0 +1,38 @@
+package util
+
+import (
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestTokenBucket(c *C) {
+	b := NewTokenBucket(100, 200)
+	now := b.last
+
+	// The burst is available right away
+	c.Assert(b.reserve(200, now), Equals, time.Duration(0))
+	// Then the rate applies
+	c.Assert(b.reserve(50, now), Equals, 500*time.Millisecond)
+	// The debt is paid off first
+	c.Assert(b.reserve(50, now), Equals, time.Second)
+
+	// Refill stops at the burst
+	now = now.Add(time.Minute)
+	c.Assert(b.reserve(200, now), Equals, time.Duration(0))
+	c.Assert(b.reserve(1, now), Equals, 10*time.Millisecond)
+
+	// A request larger than the burst does not block forever
+	now = now.Add(time.Minute)
+	c.Assert(b.reserve(300, now), Equals, time.Second)
+
+	// The burst is at least the rate
+	b = NewTokenBucket(100, 0)
+	c.Assert(b.reserve(100, b.last), Equals, time.Duration(0))
+
+	var slept time.Duration
+	b.sleep = func(d time.Duration) { slept = d }
+	b.last = time.Now()
+	b.Wait(100)
+	c.Assert(slept > 900*time.Millisecond, Equals, true)
+}
@@ -0,0 +1,214 @@
+package ptypes
+
+import (
+	context "context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/longhorn/go-common-libs/generated/profilerpb"
+
+	"github.com/longhorn/longhorn-engine/pkg/util"
+)
+
+var (
+	auditLogLock sync.RWMutex
+	auditLog     *util.AuditLog
+)
+
+// SetAuditLog makes the gRPC servers of the process record every mutating
+// call, whether it succeeded, failed or was refused, into l. Passing nil
+// turns auditing off.
+func SetAuditLog(l *util.AuditLog) {
+	auditLogLock.Lock()
+	defer auditLogLock.Unlock()
+
+	auditLog = l
+}
+
+func getAuditLog() *util.AuditLog {
+	auditLogLock.RLock()
+	defer auditLogLock.RUnlock()
+
+	return auditLog
+}
+
+// WithAuditServerInterceptor must be passed to grpc.NewServer before the other
+// interceptor options. Interceptors run in the order they are passed, so calls
+// refused by the identity validation or the control token check are audited as
+// well.
+func WithAuditServerInterceptor() grpc.ServerOption {
+	return grpc.ChainUnaryInterceptor(auditServerInterceptor)
+}
+
+func auditServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	l := getAuditLog()
+	if l == nil || !isMutatingCall(info.FullMethod, req) {
+		return handler(ctx, req)
+	}
+
+	startTime := time.Now()
+	resp, err := handler(ctx, req)
+
+	record := &util.AuditRecord{
+		Time:     startTime,
+		Method:   info.FullMethod,
+		Target:   auditTarget(req),
+		Caller:   callerIdentity(ctx),
+		Code:     status.Code(err).String(),
+		Duration: time.Since(startTime),
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+	if auditErr := l.Record(record); auditErr != nil {
+		logrus.WithError(auditErr).Errorf("Failed to audit %+v", record)
+	}
+	return resp, err
+}
+
+// auditTarget describes what a call acts on, like the replica it adds or the
+// snapshot it takes. Only the fields listed here are recorded, as some
+// requests, e.g. BackupCreate, carry credentials.
+func auditTarget(req any) string {
+	var fields []string
+	add := func(key string, value any) {
+		fields = append(fields, fmt.Sprintf("%v=%v", key, value))
+	}
+
+	switch r := req.(type) {
+	// ControllerService
+	case *VolumeStartRequest:
+		add("replicas", strings.Join(r.ReplicaAddresses, ","))
+		add("size", r.Size)
+	case *VolumeSnapshotRequest:
+		add("snapshot", r.Name)
+	case *VolumeRevertRequest:
+		add("snapshot", r.Name)
+	case *VolumeExpandRequest:
+		add("size", r.Size)
+	case *VolumeFrontendStartRequest:
+		add("frontend", r.Frontend)
+	case *VolumeUnmapMarkSnapChainRemovedSetRequest:
+		add("enabled", r.Enabled)
+	case *VolumeSnapshotMaxCountSetRequest:
+		add("count", r.Count)
+	case *VolumeSnapshotMaxSizeSetRequest:
+		add("size", r.Size)
+	case *VolumeIOLimitsSetRequest:
+		add("iops", r.Iops)
+		add("bandwidth", r.Bandwidth)
+	case *ReplicaAddress:
+		add("replica", r.Address)
+	case *ControllerReplicaCreateRequest:
+		add("replica", r.Address)
+		add("mode", r.Mode)
+	case *ControllerReplica:
+		add("replica", r.GetAddress().GetAddress())
+		add("mode", r.Mode)
+
+	// ReplicaService
+	case *ReplicaCreateRequest:
+		add("size", r.Size)
+	case *ReplicaRevertRequest:
+		add("snapshot", r.Name)
+	case *ReplicaSnapshotRequest:
+		add("snapshot", r.Name)
+	case *ReplicaExpandRequest:
+		add("size", r.Size)
+	case *DiskRemoveRequest:
+		add("disk", r.Name)
+	case *DiskReplaceRequest:
+		add("disk", r.Target)
+		add("source", r.Source)
+	case *DiskPrepareRemoveRequest:
+		add("disk", r.Name)
+	case *DiskMarkAsRemovedRequest:
+		add("disk", r.Name)
+	case *RebuildingSetRequest:
+		add("rebuilding", r.Rebuilding)
+	case *RevisionCounterSetRequest:
+		add("counter", r.Counter)
+	case *UnmapMarkDiskChainRemovedSetRequest:
+		add("enabled", r.Enabled)
+	case *SnapshotMaxCountSetRequest:
+		add("count", r.Count)
+	case *SnapshotMaxSizeSetRequest:
+		add("size", r.Size)
+
+	// SyncAgentService
+	case *FileRemoveRequest:
+		add("file", r.FileName)
+	case *FileRenameRequest:
+		add("file", r.OldFileName)
+		add("new", r.NewFileName)
+	case *FileSendRequest:
+		add("file", r.FromFileName)
+		add("to", net.JoinHostPort(r.Host, strconv.Itoa(int(r.Port))))
+	case *FilesSyncRequest:
+		add("from", r.FromAddress)
+		add("to", r.ToHost)
+	case *SnapshotCloneRequest:
+		add("snapshot", r.SnapshotFileName)
+		add("from", r.FromAddress)
+	case *VolumeExportRequest:
+		add("snapshot", r.SnapshotFileName)
+		add("to", net.JoinHostPort(r.Host, strconv.Itoa(int(r.Port))))
+	case *ReceiverLaunchRequest:
+		add("file", r.ToFileName)
+	case *BackupCreateRequest:
+		add("snapshot", r.SnapshotFileName)
+		add("backup", r.BackupName)
+	case *BackupRemoveRequest:
+		add("backup", r.Backup)
+	case *BackupRestoreRequest:
+		add("backup", r.Backup)
+		add("snapshot", r.SnapshotDiskName)
+	case *SnapshotHashRequest:
+		add("snapshot", r.SnapshotName)
+	case *SnapshotHashCancelRequest:
+		add("snapshot", r.SnapshotName)
+
+	// LogService
+	case *LogLevel:
+		add("level", r.Level)
+
+	case *profilerpb.ProfilerOPRequest:
+		add("op", r.RequestOp)
+		add("port", r.PortNumber)
+	}
+	return strings.Join(fields, " ")
+}
+
+// callerIdentity returns the address of the caller, along with the subject of
+// its verified client certificate if mutual TLS is on.
+func callerIdentity(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "unknown"
+	}
+	identity := p.Addr.String()
+	if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+		if subject := verifiedSubject(tlsInfo.State); subject != "" {
+			identity = fmt.Sprintf("%v (%v)", identity, subject)
+		}
+	}
+	return identity
+}
+
+func verifiedSubject(state tls.ConnectionState) string {
+	if len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return ""
+	}
+	return state.VerifiedChains[0][0].Subject.String()
+}
@@ -0,0 +1,83 @@
+package ptypes
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	. "gopkg.in/check.v1"
+
+	"github.com/longhorn/longhorn-engine/pkg/util"
+)
+
+func (s *TestSuite) TestAuditServerInterceptor(c *C) {
+	path := filepath.Join(c.MkDir(), "audit.log")
+	l, err := util.OpenAuditLog(path)
+	c.Assert(err, IsNil)
+	defer l.Close()
+	SetAuditLog(l)
+	defer SetAuditLog(nil)
+
+	server := grpc.NewServer(WithAuditServerInterceptor(),
+		WithIdentityValidationReplicaServerInterceptor("test-volume", ""),
+		WithControlTokenServerInterceptor())
+	RegisterReplicaServiceServer(server, &UnimplementedReplicaServiceServer{})
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	c.Assert(err, IsNil)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	dial := func(volumeName string) ReplicaServiceClient {
+		conn, err := grpc.Dial(listener.Addr().String(),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			WithIdentityValidationClientInterceptor(volumeName, ""))
+		c.Assert(err, IsNil)
+		return NewReplicaServiceClient(conn)
+	}
+
+	// Calls refused by the identity validation are audited
+	_, err = dial("other-volume").ReplicaCreate(context.Background(), &ReplicaCreateRequest{})
+	c.Assert(status.Code(err), Equals, codes.FailedPrecondition)
+
+	client := dial("test-volume")
+	_, err = client.ReplicaCreate(context.Background(), &ReplicaCreateRequest{Size: "1073741824"})
+	c.Assert(status.Code(err), Equals, codes.Unimplemented)
+
+	// Read-only calls are not
+	_, err = client.ReplicaGet(context.Background(), &emptypb.Empty{})
+	c.Assert(status.Code(err), Equals, codes.Unimplemented)
+
+	records, err := util.ReadAuditLog(path)
+	c.Assert(err, IsNil)
+	c.Assert(records, HasLen, 2)
+	c.Assert(records[0].Method, Equals, "/ptypes.ReplicaService/ReplicaCreate")
+	c.Assert(records[0].Code, Equals, codes.FailedPrecondition.String())
+	c.Assert(records[1].Method, Equals, "/ptypes.ReplicaService/ReplicaCreate")
+	c.Assert(records[1].Code, Equals, codes.Unimplemented.String())
+	c.Assert(records[1].Target, Equals, "size=1073741824")
+	c.Assert(records[1].Caller, Matches, "127.0.0.1:.*")
+}
+
+func (s *TestSuite) TestAuditTarget(c *C) {
+	c.Assert(auditTarget(&ControllerReplicaCreateRequest{Address: "tcp://10.0.0.1:10000", Mode: ReplicaMode_WO}),
+		Equals, "replica=tcp://10.0.0.1:10000 mode=WO")
+	c.Assert(auditTarget(&ReplicaAddress{Address: "tcp://10.0.0.1:10000"}), Equals, "replica=tcp://10.0.0.1:10000")
+	c.Assert(auditTarget(&VolumeSnapshotRequest{Name: "snap-1", Labels: map[string]string{"a": "b"}}), Equals, "snapshot=snap-1")
+	c.Assert(auditTarget(&VolumeExpandRequest{Size: 2147483648}), Equals, "size=2147483648")
+	c.Assert(auditTarget(&VolumeFrontendStartRequest{Frontend: "tgt-iscsi"}), Equals, "frontend=tgt-iscsi")
+	c.Assert(auditTarget(&emptypb.Empty{}), Equals, "")
+
+	// Credentials and backup targets stay out of the audit log
+	target := auditTarget(&BackupCreateRequest{
+		SnapshotFileName: "snap-1",
+		BackupName:       "backup-1",
+		BackupTarget:     "s3://user:secret@bucket/",
+		Credential:       map[string]string{"AWS_SECRET_ACCESS_KEY": "secret"},
+	})
+	c.Assert(target, Equals, "snapshot=snap-1 backup=backup-1")
+}
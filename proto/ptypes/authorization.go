@@ -0,0 +1,125 @@
+package ptypes
+
+import (
+	context "context"
+	"crypto/subtle"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/longhorn/go-common-libs/generated/profilerpb"
+)
+
+const (
+	controlTokenMetadataKey = "control-token"
+
+	profilerOPMethod = "/longhorn.common.profiler.Profiler/ProfilerOP"
+)
+
+var (
+	controlTokenLock sync.RWMutex
+	controlToken     string
+
+	// readOnlyMethods do not change any state, so they are served without a
+	// control token. Every other method of the engine services requires one.
+	readOnlyMethods = map[string]bool{
+		"/ptypes.ControllerService/VolumeGet":        true,
+		"/ptypes.ControllerService/ReplicaList":      true,
+		"/ptypes.ControllerService/ReplicaGet":       true,
+		"/ptypes.ControllerService/JournalList":      true,
+		"/ptypes.ControllerService/VersionDetailGet": true,
+		"/ptypes.ControllerService/MetricsGet":       true,
+		"/ptypes.ControllerService/VolumeEventWatch": true,
+
+		"/ptypes.ReplicaService/ReplicaGet": true,
+
+		"/ptypes.SyncAgentService/BackupStatus":          true,
+		"/ptypes.SyncAgentService/RestoreStatus":         true,
+		"/ptypes.SyncAgentService/SnapshotPurgeStatus":   true,
+		"/ptypes.SyncAgentService/ReplicaRebuildStatus":  true,
+		"/ptypes.SyncAgentService/SnapshotCloneStatus":   true,
+		"/ptypes.SyncAgentService/SnapshotHashStatus":    true,
+		"/ptypes.SyncAgentService/SnapshotHashLockState": true,
+
+		"/ptypes.LogService/LogLevelGet": true,
+	}
+
+	engineServicePrefixes = []string{
+		"/ptypes.ControllerService/",
+		"/ptypes.ReplicaService/",
+		"/ptypes.SyncAgentService/",
+		"/ptypes.LogService/",
+	}
+)
+
+// SetControlToken sets the per-volume token of the process. The gRPC servers
+// of the process refuse mutating calls that do not carry it, and the gRPC
+// clients attach it to every call. An empty token disables the check.
+func SetControlToken(token string) {
+	controlTokenLock.Lock()
+	defer controlTokenLock.Unlock()
+
+	controlToken = token
+}
+
+func getControlToken() string {
+	controlTokenLock.RLock()
+	defer controlTokenLock.RUnlock()
+
+	return controlToken
+}
+
+// isMutatingCall tells whether a call of method with req changes state, in
+// which case it requires the control token and is audited.
+func isMutatingCall(method string, req any) bool {
+	if method == profilerOPMethod {
+		// SHOW only reports the profiler state. ENABLE starts an
+		// unauthenticated pprof HTTP listener on a port the caller picks.
+		r, ok := req.(*profilerpb.ProfilerOPRequest)
+		return !ok || r.GetRequestOp() != profilerpb.Op_SHOW
+	}
+	if readOnlyMethods[method] {
+		return false
+	}
+	for _, prefix := range engineServicePrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func WithControlTokenServerInterceptor() grpc.ServerOption {
+	return grpc.ChainUnaryInterceptor(controlTokenServerInterceptor)
+}
+
+func controlTokenServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	token := getControlToken()
+	if token == "" || !isMutatingCall(info.FullMethod, req) {
+		return handler(ctx, req)
+	}
+
+	var incomingToken string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if incomingTokens := md.Get(controlTokenMetadataKey); len(incomingTokens) == 1 {
+			incomingToken = incomingTokens[0]
+		}
+	}
+	if subtle.ConstantTimeCompare([]byte(incomingToken), []byte(token)) != 1 {
+		logrus.WithField("method", info.FullMethod).Error("Refusing gRPC call without a valid control token")
+		return nil, status.Errorf(codes.PermissionDenied, "missing or invalid control token for %v", info.FullMethod)
+	}
+	return handler(ctx, req)
+}
+
+func appendControlToken(ctx context.Context) context.Context {
+	if token := getControlToken(); token != "" {
+		return metadata.AppendToOutgoingContext(ctx, controlTokenMetadataKey, token)
+	}
+	return ctx
+}
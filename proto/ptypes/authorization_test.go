@@ -0,0 +1,66 @@
+package ptypes
+
+import (
+	"testing"
+
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	. "gopkg.in/check.v1"
+
+	"github.com/longhorn/go-common-libs/generated/profilerpb"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type TestSuite struct {
+}
+
+var _ = Suite(&TestSuite{})
+
+func fullMethods(desc *grpc.ServiceDesc) []string {
+	methods := []string{}
+	for _, m := range desc.Methods {
+		methods = append(methods, "/"+desc.ServiceName+"/"+m.MethodName)
+	}
+	for _, s := range desc.Streams {
+		methods = append(methods, "/"+desc.ServiceName+"/"+s.StreamName)
+	}
+	return methods
+}
+
+func (s *TestSuite) TestIsMutatingCall(c *C) {
+	// Every method of the engine services is mutating unless listed as
+	// read-only, so a new RPC is protected by default.
+	registered := map[string]bool{}
+	for _, desc := range []*grpc.ServiceDesc{
+		&_ControllerService_serviceDesc,
+		&_ReplicaService_serviceDesc,
+		&_SyncAgentService_serviceDesc,
+		&_LogService_serviceDesc,
+	} {
+		for _, method := range fullMethods(desc) {
+			c.Assert(isMutatingCall(method, nil), Equals, !readOnlyMethods[method], Commentf(method))
+			registered[method] = true
+		}
+	}
+	// A misspelled read-only method would silently require the token
+	for method := range readOnlyMethods {
+		c.Assert(registered[method], Equals, true, Commentf(method))
+	}
+	c.Assert(isMutatingCall("/ptypes.ControllerService/VolumeSnapshot", nil), Equals, true)
+	c.Assert(isMutatingCall("/ptypes.SyncAgentService/BackupCreate", nil), Equals, true)
+	c.Assert(isMutatingCall("/ptypes.ReplicaService/ReplicaDelete", nil), Equals, true)
+	c.Assert(isMutatingCall("/ptypes.LogService/LogLevelSet", nil), Equals, true)
+
+	// Only SHOW is read-only for the profiler
+	c.Assert(fullMethods(&profilerpb.Profiler_ServiceDesc), DeepEquals, []string{profilerOPMethod})
+	c.Assert(isMutatingCall(profilerOPMethod, &profilerpb.ProfilerOPRequest{RequestOp: profilerpb.Op_SHOW}), Equals, false)
+	c.Assert(isMutatingCall(profilerOPMethod, &profilerpb.ProfilerOPRequest{RequestOp: profilerpb.Op_ENABLE, PortNumber: 6060}), Equals, true)
+	c.Assert(isMutatingCall(profilerOPMethod, &profilerpb.ProfilerOPRequest{RequestOp: profilerpb.Op_DISABLE}), Equals, true)
+	c.Assert(isMutatingCall(profilerOPMethod, nil), Equals, true)
+
+	// Health checks stay open for probes
+	for _, method := range fullMethods(&healthpb.Health_ServiceDesc) {
+		c.Assert(isMutatingCall(method, &healthpb.HealthCheckRequest{}), Equals, false, Commentf(method))
+	}
+}
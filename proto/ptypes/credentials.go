@@ -0,0 +1,60 @@
+package ptypes
+
+import (
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/longhorn/longhorn-engine/pkg/util"
+)
+
+var (
+	grpcTLSLock       sync.RWMutex
+	grpcTLSReloader   *util.TLSReloader
+	grpcTLSServerName string
+)
+
+// SetGRPCTLS enables TLS for all gRPC servers and clients of the process.
+// If the reloader has a CA, servers require client certificates signed by
+// it (mutual TLS). serverName overrides the name clients expect in server
+// certificates. Passing a nil reloader goes back to plaintext.
+func SetGRPCTLS(reloader *util.TLSReloader, serverName string) {
+	grpcTLSLock.Lock()
+	defer grpcTLSLock.Unlock()
+
+	grpcTLSReloader = reloader
+	grpcTLSServerName = serverName
+}
+
+func getGRPCTLS() (*util.TLSReloader, string) {
+	grpcTLSLock.RLock()
+	defer grpcTLSLock.RUnlock()
+
+	return grpcTLSReloader, grpcTLSServerName
+}
+
+// WithServerTransportCredentials returns the transport credentials option
+// for a gRPC server. It must be called after SetGRPCTLS.
+func WithServerTransportCredentials() (grpc.ServerOption, error) {
+	reloader, _ := getGRPCTLS()
+	if reloader == nil {
+		return grpc.EmptyServerOption{}, nil
+	}
+	config, err := reloader.ServerConfig()
+	if err != nil {
+		return nil, err
+	}
+	return grpc.Creds(credentials.NewTLS(config)), nil
+}
+
+// WithClientTransportCredentials returns the transport credentials option
+// for dialing the gRPC servers of the engine.
+func WithClientTransportCredentials() grpc.DialOption {
+	reloader, serverName := getGRPCTLS()
+	if reloader == nil {
+		return grpc.WithTransportCredentials(insecure.NewCredentials())
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(reloader.ClientConfig(serverName)))
+}
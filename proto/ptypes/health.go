@@ -0,0 +1,37 @@
+package ptypes
+
+import (
+	"time"
+
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	healthWatchInterval = time.Second
+)
+
+// WatchHealth implements the Watch call of the gRPC health service. It sends
+// the status returned by check right away, then each time it changes, until
+// the client goes away.
+func WatchHealth(ws healthpb.Health_WatchServer, check func() healthpb.HealthCheckResponse_ServingStatus) error {
+	ticker := time.NewTicker(healthWatchInterval)
+	defer ticker.Stop()
+
+	lastStatus := healthpb.HealthCheckResponse_UNKNOWN
+	first := true
+	for {
+		if status := check(); first || status != lastStatus {
+			if err := ws.Send(&healthpb.HealthCheckResponse{Status: status}); err != nil {
+				return err
+			}
+			lastStatus = status
+			first = false
+		}
+
+		select {
+		case <-ws.Context().Done():
+			return ws.Context().Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -11,53 +11,24 @@ import (
 )
 
 func WithIdentityValidationControllerServerInterceptor(volumeName, instanceName string) grpc.ServerOption {
-	return grpc.UnaryInterceptor(identityValidationServerInterceptor(volumeName, instanceName, "controller"))
+	return grpc.ChainUnaryInterceptor(identityValidationServerInterceptor(volumeName, instanceName, "controller"))
+}
+
+// WithIdentityValidationControllerStreamServerInterceptor validates the
+// identity of streaming calls, like the unary interceptor does for the others.
+func WithIdentityValidationControllerStreamServerInterceptor(volumeName, instanceName string) grpc.ServerOption {
+	return grpc.ChainStreamInterceptor(identityValidationStreamServerInterceptor(volumeName, instanceName, "controller"))
 }
 
 func WithIdentityValidationReplicaServerInterceptor(volumeName, instanceName string) grpc.ServerOption {
-	return grpc.UnaryInterceptor(identityValidationServerInterceptor(volumeName, instanceName, "replica"))
+	return grpc.ChainUnaryInterceptor(identityValidationServerInterceptor(volumeName, instanceName, "replica"))
 }
 
 func identityValidationServerInterceptor(volumeName, instanceName, serverType string) grpc.UnaryServerInterceptor {
 	// Use a closure to remember the correct volumeName and/or instanceName.
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
-		md, ok := metadata.FromIncomingContext(ctx)
-		if ok {
-			var incomingVolumeName string
-			incomingVolumeNames := md.Get("volume-name")
-			if len(incomingVolumeNames) == 1 {
-				// If len > 1, why? There is no legitimate reason, so do not validate.
-				incomingVolumeName = incomingVolumeNames[0]
-			}
-			// Only refuse to serve if both client and server provide validation information.
-			if incomingVolumeName != "" && volumeName != "" {
-				log := logrus.WithFields(logrus.Fields{"method": info.FullMethod,
-					"clientVolumeName": incomingVolumeName, "serverVolumeName": volumeName})
-				if incomingVolumeName != volumeName {
-					log.Error("Invalid gRPC metadata")
-					return nil, status.Errorf(codes.FailedPrecondition, "incorrect volume name %s; check %s address",
-						incomingVolumeName, serverType)
-				}
-				log.Trace("Valid gRPC metadata")
-			}
-
-			var incomingInstanceName string
-			incomingInstanceNames := md.Get("instance-name")
-			if len(incomingInstanceNames) == 1 {
-				// If len > 1, why? There is no legitimate reason, so do not validate.
-				incomingInstanceName = incomingInstanceNames[0]
-			}
-			// Only refuse to serve if both client and server provide validation information.
-			if incomingInstanceName != "" && instanceName != "" {
-				log := logrus.WithFields(logrus.Fields{"method": info.FullMethod,
-					"clientInstanceName": incomingInstanceName, "serverInstanceName": instanceName})
-				if incomingInstanceName != instanceName {
-					log.Error("Invalid gRPC metadata")
-					return nil, status.Errorf(codes.FailedPrecondition, "incorrect instance name %s; check %s address",
-						incomingInstanceName, serverType)
-				}
-				log.Trace("Valid gRPC metadata")
-			}
+		if err := validateIdentity(ctx, info.FullMethod, volumeName, instanceName, serverType); err != nil {
+			return nil, err
 		}
 
 		// Call the RPC's actual handler.
@@ -65,6 +36,63 @@ func identityValidationServerInterceptor(volumeName, instanceName, serverType st
 	}
 }
 
+func identityValidationStreamServerInterceptor(volumeName, instanceName, serverType string) grpc.StreamServerInterceptor {
+	// Use a closure to remember the correct volumeName and/or instanceName.
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := validateIdentity(ss.Context(), info.FullMethod, volumeName, instanceName, serverType); err != nil {
+			return err
+		}
+
+		// Call the RPC's actual handler.
+		return handler(srv, ss)
+	}
+}
+
+// validateIdentity refuses a call whose metadata names another volume or
+// instance than the server's.
+func validateIdentity(ctx context.Context, method, volumeName, instanceName, serverType string) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		var incomingVolumeName string
+		incomingVolumeNames := md.Get("volume-name")
+		if len(incomingVolumeNames) == 1 {
+			// If len > 1, why? There is no legitimate reason, so do not validate.
+			incomingVolumeName = incomingVolumeNames[0]
+		}
+		// Only refuse to serve if both client and server provide validation information.
+		if incomingVolumeName != "" && volumeName != "" {
+			log := logrus.WithFields(logrus.Fields{"method": method,
+				"clientVolumeName": incomingVolumeName, "serverVolumeName": volumeName})
+			if incomingVolumeName != volumeName {
+				log.Error("Invalid gRPC metadata")
+				return status.Errorf(codes.FailedPrecondition, "incorrect volume name %s; check %s address",
+					incomingVolumeName, serverType)
+			}
+			log.Trace("Valid gRPC metadata")
+		}
+
+		var incomingInstanceName string
+		incomingInstanceNames := md.Get("instance-name")
+		if len(incomingInstanceNames) == 1 {
+			// If len > 1, why? There is no legitimate reason, so do not validate.
+			incomingInstanceName = incomingInstanceNames[0]
+		}
+		// Only refuse to serve if both client and server provide validation information.
+		if incomingInstanceName != "" && instanceName != "" {
+			log := logrus.WithFields(logrus.Fields{"method": method,
+				"clientInstanceName": incomingInstanceName, "serverInstanceName": instanceName})
+			if incomingInstanceName != instanceName {
+				log.Error("Invalid gRPC metadata")
+				return status.Errorf(codes.FailedPrecondition, "incorrect instance name %s; check %s address",
+					incomingInstanceName, serverType)
+			}
+			log.Trace("Valid gRPC metadata")
+		}
+	}
+
+	return nil
+}
+
 func WithIdentityValidationClientInterceptor(volumeName, instanceName string) grpc.DialOption {
 	return grpc.WithUnaryInterceptor(identityValidationClientInterceptor(volumeName, instanceName))
 }
@@ -73,12 +101,32 @@ func identityValidationClientInterceptor(volumeName, instanceName string) grpc.U
 	// Use a closure to remember the correct volumeName and/or instanceName.
 	return func(ctx context.Context, method string, req any, reply any, cc *grpc.ClientConn,
 		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
-		if volumeName != "" {
-			ctx = metadata.AppendToOutgoingContext(ctx, "volume-name", volumeName)
-		}
-		if instanceName != "" {
-			ctx = metadata.AppendToOutgoingContext(ctx, "instance-name", instanceName)
-		}
+		ctx = appendIdentity(ctx, volumeName, instanceName)
 		return invoker(ctx, method, req, reply, cc, opts...)
 	}
 }
+
+// WithIdentityValidationStreamClientInterceptor sends the identity and the
+// control token along with streaming calls.
+func WithIdentityValidationStreamClientInterceptor(volumeName, instanceName string) grpc.DialOption {
+	return grpc.WithStreamInterceptor(identityValidationStreamClientInterceptor(volumeName, instanceName))
+}
+
+func identityValidationStreamClientInterceptor(volumeName, instanceName string) grpc.StreamClientInterceptor {
+	// Use a closure to remember the correct volumeName and/or instanceName.
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string,
+		streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctx = appendIdentity(ctx, volumeName, instanceName)
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
+
+func appendIdentity(ctx context.Context, volumeName, instanceName string) context.Context {
+	if volumeName != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "volume-name", volumeName)
+	}
+	if instanceName != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "instance-name", instanceName)
+	}
+	return appendControlToken(ctx)
+}
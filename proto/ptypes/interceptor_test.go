@@ -0,0 +1,46 @@
+package ptypes
+
+import (
+	context "context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	. "gopkg.in/check.v1"
+)
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *TestSuite) TestIdentityValidationStreamServerInterceptor(c *C) {
+	interceptor := identityValidationStreamServerInterceptor("test-volume", "test-instance", "controller")
+	info := &grpc.StreamServerInfo{FullMethod: "/ptypes.ControllerService/VolumeEventWatch", IsServerStream: true}
+
+	for _, test := range []struct {
+		md     metadata.MD
+		served bool
+	}{
+		{metadata.Pairs(), true},
+		{metadata.Pairs("volume-name", "test-volume", "instance-name", "test-instance"), true},
+		{metadata.Pairs("volume-name", "other-volume"), false},
+		{metadata.Pairs("volume-name", "test-volume", "instance-name", "other-instance"), false},
+	} {
+		served := false
+		stream := &testServerStream{ctx: metadata.NewIncomingContext(context.Background(), test.md)}
+		err := interceptor(nil, stream, info, func(srv any, ss grpc.ServerStream) error {
+			served = true
+			return nil
+		})
+		c.Assert(served, Equals, test.served, Commentf("%v", test.md))
+		if !test.served {
+			c.Assert(status.Code(err), Equals, codes.FailedPrecondition)
+		}
+	}
+}
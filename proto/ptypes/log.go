@@ -0,0 +1,35 @@
+package ptypes
+
+import (
+	context "context"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// LogServer reports and changes the log level of the process. It is served
+// by the controller, replica and sync agent gRPC servers, so every process of
+// a volume can be switched to debug logging without a restart.
+type LogServer struct {
+	UnimplementedLogServiceServer
+}
+
+func NewLogServer() *LogServer {
+	return &LogServer{}
+}
+
+func (s *LogServer) LogLevelGet(ctx context.Context, req *emptypb.Empty) (*LogLevel, error) {
+	return &LogLevel{Level: logrus.GetLevel().String()}, nil
+}
+
+func (s *LogServer) LogLevelSet(ctx context.Context, req *LogLevel) (*LogLevel, error) {
+	level, err := logrus.ParseLevel(req.Level)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	logrus.Infof("Changing log level from %v to %v", logrus.GetLevel(), level)
+	logrus.SetLevel(level)
+	return &LogLevel{Level: level.String()}, nil
+}
@@ -0,0 +1,32 @@
+package ptypes
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestLogServer(c *C) {
+	defer logrus.SetLevel(logrus.GetLevel())
+	logrus.SetLevel(logrus.InfoLevel)
+
+	server := NewLogServer()
+	level, err := server.LogLevelGet(context.Background(), &emptypb.Empty{})
+	c.Assert(err, IsNil)
+	c.Assert(level.Level, Equals, "info")
+
+	level, err = server.LogLevelSet(context.Background(), &LogLevel{Level: "debug"})
+	c.Assert(err, IsNil)
+	c.Assert(level.Level, Equals, "debug")
+	c.Assert(logrus.GetLevel(), Equals, logrus.DebugLevel)
+
+	_, err = server.LogLevelSet(context.Background(), &LogLevel{Level: "invalid"})
+	c.Assert(status.Code(err), Equals, codes.InvalidArgument)
+	c.Assert(logrus.GetLevel(), Equals, logrus.DebugLevel)
+
+	c.Assert(auditTarget(&LogLevel{Level: "debug"}), Equals, "level=debug")
+}